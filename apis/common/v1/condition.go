@@ -18,6 +18,7 @@ package v1
 
 import (
 	"sort"
+	"unicode/utf8"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,6 +35,14 @@ const (
 	// TypeSynced resources are believed to be in sync with the
 	// Kubernetes resources that manage their lifecycle.
 	TypeSynced ConditionType = "Synced"
+
+	// TypeUpToDate resources are believed to match the desired state of the
+	// external resources they represent.
+	TypeUpToDate ConditionType = "UpToDate"
+
+	// TypeHealthy resources are believed to represent external resources that
+	// are in a good state, as reported by the external system.
+	TypeHealthy ConditionType = "Healthy"
 )
 
 // A ConditionReason represents the reason a resource is in a condition.
@@ -49,8 +58,28 @@ const (
 
 // Reasons a resource is or is not synced.
 const (
-	ReasonReconcileSuccess ConditionReason = "ReconcileSuccess"
-	ReasonReconcileError   ConditionReason = "ReconcileError"
+	ReasonReconcileSuccess       ConditionReason = "ReconcileSuccess"
+	ReasonReconcileError         ConditionReason = "ReconcileError"
+	ReasonObserveOnly            ConditionReason = "ObserveOnly"
+	ReasonCannotSaveExternalName ConditionReason = "CannotSaveExternalName"
+	ReasonPanicRecovered         ConditionReason = "PanicRecovered"
+	ReasonReconcilePaused        ConditionReason = "ReconcilePaused"
+	ReasonReconcileTimeout       ConditionReason = "ReconcileTimeout"
+	ReasonReferencesPending      ConditionReason = "ReferencesPending"
+	ReasonExternalPending        ConditionReason = "ExternalPending"
+)
+
+// Reasons a resource is or is not up to date.
+const (
+	ReasonUpToDate ConditionReason = "UpToDate"
+	ReasonDrifted  ConditionReason = "Drifted"
+)
+
+// Reasons a resource is or is not healthy.
+const (
+	ReasonHealthy       ConditionReason = "Healthy"
+	ReasonUnhealthy     ConditionReason = "Unhealthy"
+	ReasonHealthUnknown ConditionReason = "HealthUnknown"
 )
 
 // A Condition that may apply to a resource.
@@ -73,6 +102,14 @@ type Condition struct {
 	// one status to another, if any.
 	// +optional
 	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration represents the .metadata.generation that the
+	// condition was set based upon. For instance, if .metadata.generation is
+	// currently 12, but the .status.conditions[x].observedGeneration is 9, the
+	// condition is out of date with respect to the current state of the
+	// resource.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 // Equal returns true if the condition is identical to the supplied condition,
@@ -81,7 +118,8 @@ func (c Condition) Equal(other Condition) bool {
 	return c.Type == other.Type &&
 		c.Status == other.Status &&
 		c.Reason == other.Reason &&
-		c.Message == other.Message
+		c.Message == other.Message &&
+		c.ObservedGeneration == other.ObservedGeneration
 }
 
 // WithMessage returns a condition by adding the provided message to existing
@@ -91,6 +129,39 @@ func (c Condition) WithMessage(msg string) Condition {
 	return c
 }
 
+// WithObservedGeneration returns a condition by adding the provided observed
+// generation to existing condition.
+func (c Condition) WithObservedGeneration(gen int64) Condition {
+	c.ObservedGeneration = gen
+	return c
+}
+
+// DefaultMaxMessageLength is the default maximum length of a condition
+// message, in bytes.
+const DefaultMaxMessageLength = 2048
+
+// messageEllipsis is appended to truncated condition messages.
+const messageEllipsis = "…"
+
+// TruncateMessage returns the supplied message truncated to at most max bytes.
+// Messages are truncated on a rune boundary, and an ellipsis is appended to
+// any message that is truncated. The message is returned unchanged if max is
+// zero or less.
+func TruncateMessage(msg string, max int) string {
+	if max <= 0 || len(msg) <= max {
+		return msg
+	}
+	suffix := messageEllipsis
+	if max < len(suffix) {
+		suffix = ""
+	}
+	end := max - len(suffix)
+	for end > 0 && !utf8.RuneStart(msg[end]) {
+		end--
+	}
+	return msg[:end] + suffix
+}
+
 // NOTE(negz): Conditions are implemented as a slice rather than a map to comply
 // with Kubernetes API conventions. Ideally we'd comply by using a map that
 // marshalled to a JSON array, but doing so confuses the CRD schema generator.
@@ -150,6 +221,26 @@ func (s *ConditionedStatus) SetConditions(c ...Condition) {
 	}
 }
 
+// RemoveConditions removes any existing conditions of the supplied types. This
+// is a no-op if no conditions of the supplied types are set.
+func (s *ConditionedStatus) RemoveConditions(ct ...ConditionType) {
+	remove := make(map[ConditionType]bool, len(ct))
+	for _, t := range ct {
+		remove[t] = true
+	}
+
+	kept := s.Conditions[:0]
+	for _, c := range s.Conditions {
+		if !remove[c.Type] {
+			kept = append(kept, c)
+		}
+	}
+	if len(kept) == 0 {
+		kept = nil
+	}
+	s.Conditions = kept
+}
+
 // Equal returns true if the status is identical to the supplied status,
 // ignoring the LastTransitionTimes and order of statuses.
 func (s *ConditionedStatus) Equal(other *ConditionedStatus) bool {
@@ -250,3 +341,148 @@ func ReconcileError(err error) Condition {
 		Message:            err.Error(),
 	}
 }
+
+// CannotSaveExternalName returns a condition indicating that Crossplane
+// created an external resource, but could not persist its external name. The
+// external resource may be orphaned if its external name is never persisted.
+func CannotSaveExternalName(err error) Condition {
+	return Condition{
+		Type:               TypeSynced,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCannotSaveExternalName,
+		Message:            err.Error(),
+	}
+}
+
+// PanicRecovered returns a condition indicating that Crossplane recovered from
+// a panic while reconciling the resource.
+func PanicRecovered(err error) Condition {
+	return Condition{
+		Type:               TypeSynced,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPanicRecovered,
+		Message:            err.Error(),
+	}
+}
+
+// ObserveOnly returns a condition indicating that Crossplane successfully
+// observed the resource, but will never create, update, or delete it.
+func ObserveOnly() Condition {
+	return Condition{
+		Type:               TypeSynced,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonObserveOnly,
+	}
+}
+
+// ReconcilePaused returns a condition indicating that reconciliation of the
+// resource is paused via the pause annotation.
+func ReconcilePaused() Condition {
+	return Condition{
+		Type:               TypeSynced,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonReconcilePaused,
+	}
+}
+
+// ReferencesPending returns a condition indicating that Crossplane could not
+// reconcile the resource because the supplied error indicates that some of the
+// resources it references do not exist or are not yet ready.
+func ReferencesPending(err error) Condition {
+	return Condition{
+		Type:               TypeSynced,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonReferencesPending,
+		Message:            err.Error(),
+	}
+}
+
+// ExternalPending returns a condition indicating that Crossplane is waiting
+// for the external resource to reach a state in which it can be reconciled,
+// for example for a recently created external resource to be observable.
+func ExternalPending() Condition {
+	return Condition{
+		Type:               TypeSynced,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonExternalPending,
+	}
+}
+
+// ReconcileTimeout returns a condition indicating that Crossplane encountered
+// the supplied error because reconciliation of the resource took longer than
+// its deadline.
+func ReconcileTimeout(err error) Condition {
+	return Condition{
+		Type:               TypeSynced,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonReconcileTimeout,
+		Message:            err.Error(),
+	}
+}
+
+// UpToDate returns a condition indicating that the external resource was
+// observed to match the desired state of the resource.
+func UpToDate() Condition {
+	return Condition{
+		Type:               TypeUpToDate,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpToDate,
+	}
+}
+
+// Drifted returns a condition indicating that the external resource was
+// observed to differ from the desired state of the resource. The supplied
+// message should describe how it differs.
+func Drifted(msg string) Condition {
+	return Condition{
+		Type:               TypeUpToDate,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDrifted,
+		Message:            msg,
+	}
+}
+
+// Healthy returns a condition indicating that the external resource is
+// reported to be in a good state by the external system.
+func Healthy() Condition {
+	return Condition{
+		Type:               TypeHealthy,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonHealthy,
+	}
+}
+
+// Unhealthy returns a condition indicating that the external resource is
+// reported to be in a bad state by the external system. Unhealthy is distinct
+// from ReconcileError; Crossplane may be able to reconcile an external resource
+// that is unhealthy. The supplied message should describe why it is unhealthy.
+func Unhealthy(msg string) Condition {
+	return Condition{
+		Type:               TypeHealthy,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnhealthy,
+		Message:            msg,
+	}
+}
+
+// HealthUnknown returns a condition indicating that the health of the external
+// resource could not be determined.
+func HealthUnknown() Condition {
+	return Condition{
+		Type:               TypeHealthy,
+		Status:             corev1.ConditionUnknown,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonHealthUnknown,
+	}
+}
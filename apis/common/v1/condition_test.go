@@ -57,6 +57,11 @@ func TestConditionEqual(t *testing.T) {
 			b:    Condition{Message: "uncool"},
 			want: false,
 		},
+		"DifferentObservedGeneration": {
+			a:    Condition{ObservedGeneration: 1},
+			b:    Condition{ObservedGeneration: 2},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -154,6 +159,41 @@ func TestSetConditions(t *testing.T) {
 	}
 }
 
+func TestRemoveConditions(t *testing.T) {
+	cases := map[string]struct {
+		cs   *ConditionedStatus
+		t    []ConditionType
+		want *ConditionedStatus
+	}{
+		"TypeExists": {
+			cs:   NewConditionedStatus(Available(), ReconcileSuccess()),
+			t:    []ConditionType{TypeReady},
+			want: NewConditionedStatus(ReconcileSuccess()),
+		},
+		"TypeDoesNotExist": {
+			cs:   NewConditionedStatus(ReconcileSuccess()),
+			t:    []ConditionType{TypeReady},
+			want: NewConditionedStatus(ReconcileSuccess()),
+		},
+		"AllTypesRemoved": {
+			cs:   NewConditionedStatus(Available(), ReconcileSuccess()),
+			t:    []ConditionType{TypeReady, TypeSynced},
+			want: NewConditionedStatus(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.cs.RemoveConditions(tc.t...)
+
+			got := tc.cs
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("tc.cs.RemoveConditions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGetCondition(t *testing.T) {
 	cases := map[string]struct {
 		cs   *ConditionedStatus
@@ -219,3 +259,54 @@ func TestConditionWithMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestTruncateMessage(t *testing.T) {
+	cases := map[string]struct {
+		msg  string
+		max  int
+		want string
+	}{
+		"ShortMessage": {
+			msg:  "cool",
+			max:  10,
+			want: "cool",
+		},
+		"ExactLength": {
+			msg:  "cool",
+			max:  4,
+			want: "cool",
+		},
+		"NoLimit": {
+			msg:  "cool",
+			max:  0,
+			want: "cool",
+		},
+		"Truncated": {
+			msg:  "very cool message",
+			max:  10,
+			want: "very co…",
+		},
+		"RuneBoundary": {
+			msg:  "cool ☃☃☃",
+			max:  10,
+			want: "cool …",
+		},
+		"TinyLimit": {
+			msg:  "cool",
+			max:  2,
+			want: "co",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := TruncateMessage(tc.msg, tc.max)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("TruncateMessage(...): -want, +got:\n%s", diff)
+			}
+			if tc.max > 0 && len(got) > tc.max {
+				t.Errorf("TruncateMessage(...): len(%q) exceeds %d", got, tc.max)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package v1
 import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 const (
@@ -123,6 +124,36 @@ type SecretStoreConfig struct {
 	Vault *VaultSecretStoreConfig `json:"vault,omitempty"`
 }
 
+// Validate returns an error if the configuration block matching the secret
+// store type is missing, or if a configuration block for a different type is
+// provided. A nil type is treated as Kubernetes, and a Kubernetes secret store
+// may omit its configuration block to use in cluster config. Field paths in
+// the returned error are relative to the SecretStoreConfig.
+func (in *SecretStoreConfig) Validate() error {
+	t := SecretStoreKubernetes
+	if in.Type != nil {
+		t = *in.Type
+	}
+
+	var errs field.ErrorList
+	switch t {
+	case SecretStoreKubernetes:
+		if in.Vault != nil {
+			errs = append(errs, field.Forbidden(field.NewPath("vault"), "must not be set when type is Kubernetes"))
+		}
+	case SecretStoreVault:
+		if in.Vault == nil {
+			errs = append(errs, field.Required(field.NewPath("vault"), "must be set when type is Vault"))
+		}
+		if in.Kubernetes != nil {
+			errs = append(errs, field.Forbidden(field.NewPath("kubernetes"), "must not be set when type is Vault"))
+		}
+	default:
+		errs = append(errs, field.NotSupported(field.NewPath("type"), t, []string{string(SecretStoreKubernetes), string(SecretStoreVault)}))
+	}
+	return errs.ToAggregate()
+}
+
 // KubernetesAuthConfig required to authenticate to a K8s API. It expects
 // a "kubeconfig" file to be provided.
 type KubernetesAuthConfig struct {
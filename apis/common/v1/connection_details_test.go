@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestSecretStoreConfigValidate(t *testing.T) {
+	kubernetes := SecretStoreKubernetes
+	vault := SecretStoreVault
+	unknown := SecretStoreType("Unknown")
+
+	cases := map[string]struct {
+		reason string
+		cfg    SecretStoreConfig
+		want   error
+	}{
+		"DefaultType": {
+			reason: "A config with no type and no configuration blocks should be valid.",
+			cfg:    SecretStoreConfig{},
+		},
+		"KubernetesWithConfig": {
+			reason: "A Kubernetes config with a Kubernetes configuration block should be valid.",
+			cfg:    SecretStoreConfig{Type: &kubernetes, Kubernetes: &KubernetesSecretStoreConfig{}},
+		},
+		"KubernetesWithVault": {
+			reason: "A Kubernetes config with a Vault configuration block should be invalid.",
+			cfg:    SecretStoreConfig{Type: &kubernetes, Vault: &VaultSecretStoreConfig{}},
+			want:   field.ErrorList{field.Forbidden(field.NewPath("vault"), "must not be set when type is Kubernetes")}.ToAggregate(),
+		},
+		"Vault": {
+			reason: "A Vault config with a Vault configuration block should be valid.",
+			cfg:    SecretStoreConfig{Type: &vault, Vault: &VaultSecretStoreConfig{}},
+		},
+		"VaultMissingConfig": {
+			reason: "A Vault config without a Vault configuration block should be invalid.",
+			cfg:    SecretStoreConfig{Type: &vault, Kubernetes: &KubernetesSecretStoreConfig{}},
+			want: field.ErrorList{
+				field.Required(field.NewPath("vault"), "must be set when type is Vault"),
+				field.Forbidden(field.NewPath("kubernetes"), "must not be set when type is Vault"),
+			}.ToAggregate(),
+		},
+		"UnknownType": {
+			reason: "A config with an unknown type should be invalid.",
+			cfg:    SecretStoreConfig{Type: &unknown},
+			want:   field.ErrorList{field.NotSupported(field.NewPath("type"), unknown, []string{"Kubernetes", "Vault"})}.ToAggregate(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.cfg.Validate()
+			if diff := cmp.Diff(errString(tc.want), errString(got)); diff != "" {
+				t.Errorf("\n%s\ncfg.Validate(): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -58,9 +58,16 @@ const (
 	// be tried to resolve for every reconcile loop.
 	ResolvePolicyAlways ResolvePolicy = "Always"
 
+	// ResolvePolicyIfNotPresent is a resolve option.
+	// When the ResolvePolicy is set to ResolvePolicyIfNotPresent the reference
+	// will be resolved only when the corresponding field is not present. It is
+	// the default resolve policy.
+	ResolvePolicyIfNotPresent ResolvePolicy = "IfNotPresent"
+
 	// ResolutionPolicyRequired is a resolution option.
 	// When the ResolutionPolicy is set to ResolutionPolicyRequired the execution
-	// could not continue even if the reference cannot be resolved.
+	// could not continue even if the reference cannot be resolved. It is the
+	// default resolution policy.
 	ResolutionPolicyRequired ResolutionPolicy = "Required"
 
 	// ResolutionPolicyOptional is a resolution option.
@@ -68,3 +75,31 @@ const (
 	// execution could continue even if the reference cannot be resolved.
 	ResolutionPolicyOptional ResolutionPolicy = "Optional"
 )
+
+// String returns the string representation of the resolve policy.
+func (p ResolvePolicy) String() string {
+	return string(p)
+}
+
+// String returns the string representation of the resolution policy.
+func (p ResolutionPolicy) String() string {
+	return string(p)
+}
+
+// A SelectionStrategy determines which of several objects that match a
+// Selector is selected.
+type SelectionStrategy string
+
+const (
+	// SelectionStrategyOldest selects the matching object with the earliest
+	// creation timestamp.
+	SelectionStrategyOldest SelectionStrategy = "Oldest"
+
+	// SelectionStrategyNewest selects the matching object with the latest
+	// creation timestamp.
+	SelectionStrategyNewest SelectionStrategy = "Newest"
+
+	// SelectionStrategyLexical selects the matching object whose name sorts
+	// first lexically.
+	SelectionStrategyLexical SelectionStrategy = "Lexical"
+)
@@ -97,20 +97,32 @@ type Policy struct {
 	Resolution *ResolutionPolicy `json:"resolution,omitempty"`
 }
 
-// IsResolutionPolicyOptional checks whether the resolution policy of relevant reference is Optional.
-func (p *Policy) IsResolutionPolicyOptional() bool {
+// ResolveOrDefault returns the resolve policy of the reference, or
+// ResolvePolicyIfNotPresent if none is set.
+func (p *Policy) ResolveOrDefault() ResolvePolicy {
+	if p == nil || p.Resolve == nil {
+		return ResolvePolicyIfNotPresent
+	}
+	return *p.Resolve
+}
+
+// ResolutionOrDefault returns the resolution policy of the reference, or
+// ResolutionPolicyRequired if none is set.
+func (p *Policy) ResolutionOrDefault() ResolutionPolicy {
 	if p == nil || p.Resolution == nil {
-		return false
+		return ResolutionPolicyRequired
 	}
-	return *p.Resolution == ResolutionPolicyOptional
+	return *p.Resolution
+}
+
+// IsResolutionPolicyOptional checks whether the resolution policy of relevant reference is Optional.
+func (p *Policy) IsResolutionPolicyOptional() bool {
+	return p.ResolutionOrDefault() == ResolutionPolicyOptional
 }
 
 // IsResolvePolicyAlways checks whether the resolution policy of relevant reference is Always.
 func (p *Policy) IsResolvePolicyAlways() bool {
-	if p == nil || p.Resolve == nil {
-		return false
-	}
-	return *p.Resolve == ResolvePolicyAlways
+	return p.ResolveOrDefault() == ResolvePolicyAlways
 }
 
 // A Reference to a named object.
@@ -153,6 +165,15 @@ type Selector struct {
 	// Policies for selection.
 	// +optional
 	Policy *Policy `json:"policy,omitempty"`
+
+	// SelectionStrategy specifies how to choose between multiple objects
+	// that match the selector. 'Oldest' chooses the object that was created
+	// first, 'Newest' chooses the object that was created most recently, and
+	// 'Lexical' chooses the object whose name sorts first. By default the
+	// first object returned by the API server is chosen.
+	// +optional
+	// +kubebuilder:validation:Enum=Oldest;Newest;Lexical
+	SelectionStrategy *SelectionStrategy `json:"selectionStrategy,omitempty"`
 }
 
 // SetGroupVersionKind sets the Kind and APIVersion of a TypedReference.
@@ -168,6 +189,29 @@ func (obj *TypedReference) GroupVersionKind() schema.GroupVersionKind {
 // GetObjectKind get the ObjectKind of a TypedReference.
 func (obj *TypedReference) GetObjectKind() schema.ObjectKind { return obj }
 
+// ToObjectReference returns an ObjectReference with the APIVersion, Kind, Name,
+// and UID of this TypedReference.
+func (obj *TypedReference) ToObjectReference() corev1.ObjectReference {
+	return corev1.ObjectReference{
+		APIVersion: obj.APIVersion,
+		Kind:       obj.Kind,
+		Name:       obj.Name,
+		UID:        obj.UID,
+	}
+}
+
+// TypedReferenceFromObjectReference returns a TypedReference with the
+// APIVersion, Kind, Name, and UID of the supplied ObjectReference. All other
+// fields of the ObjectReference, including its namespace, are discarded.
+func TypedReferenceFromObjectReference(o corev1.ObjectReference) TypedReference {
+	return TypedReference{
+		APIVersion: o.APIVersion,
+		Kind:       o.Kind,
+		Name:       o.Name,
+		UID:        o.UID,
+	}
+}
+
 // TODO(negz): Rename Resource* to Managed* to clarify that they enable the
 // resource.Managed interface.
 
@@ -0,0 +1,90 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestPolicyOrDefault(t *testing.T) {
+	always := ResolvePolicyAlways
+	optional := ResolutionPolicyOptional
+
+	type want struct {
+		resolve    ResolvePolicy
+		resolution ResolutionPolicy
+	}
+	cases := map[string]struct {
+		reason string
+		p      *Policy
+		want   want
+	}{
+		"NilPolicy": {
+			reason: "A nil policy should return the default policies.",
+			want: want{
+				resolve:    ResolvePolicyIfNotPresent,
+				resolution: ResolutionPolicyRequired,
+			},
+		},
+		"UnsetPolicies": {
+			reason: "A policy with no policies set should return the default policies.",
+			p:      &Policy{},
+			want: want{
+				resolve:    ResolvePolicyIfNotPresent,
+				resolution: ResolutionPolicyRequired,
+			},
+		},
+		"SetPolicies": {
+			reason: "A policy with policies set should return those policies.",
+			p:      &Policy{Resolve: &always, Resolution: &optional},
+			want: want{
+				resolve:    ResolvePolicyAlways,
+				resolution: ResolutionPolicyOptional,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want.resolve, tc.p.ResolveOrDefault()); diff != "" {
+				t.Errorf("\n%s\np.ResolveOrDefault(): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.resolution, tc.p.ResolutionOrDefault()); diff != "" {
+				t.Errorf("\n%s\np.ResolutionOrDefault(): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTypedReferenceObjectReference(t *testing.T) {
+	tr := TypedReference{APIVersion: "example.org/v1", Kind: "Cool", Name: "cool", UID: "very-unique"}
+	or := corev1.ObjectReference{APIVersion: "example.org/v1", Kind: "Cool", Name: "cool", UID: "very-unique"}
+
+	if diff := cmp.Diff(or, tr.ToObjectReference()); diff != "" {
+		t.Errorf("tr.ToObjectReference(): -want, +got:\n%s", diff)
+	}
+
+	withNamespace := or
+	withNamespace.Namespace = "default"
+	withNamespace.FieldPath = "spec.cool"
+	if diff := cmp.Diff(tr, TypedReferenceFromObjectReference(withNamespace)); diff != "" {
+		t.Errorf("TypedReferenceFromObjectReference(...): -want, +got:\n%s", diff)
+	}
+}
@@ -464,6 +464,11 @@ func (in *Selector) DeepCopyInto(out *Selector) {
 		*out = new(Policy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SelectionStrategy != nil {
+		in, out := &in.SelectionStrategy, &out.SelectionStrategy
+		*out = new(SelectionStrategy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Selector.
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conditions contains utilities for setting the status conditions of
+// Crossplane resources.
+package conditions
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// A Manager marks the status conditions of resources.
+type Manager interface {
+	// MarkConditions sets the supplied conditions on the supplied object.
+	MarkConditions(o resource.Object, c ...xpv1.Condition)
+}
+
+// A ManagerFn is a function that satisfies the Manager interface.
+type ManagerFn func(o resource.Object, c ...xpv1.Condition)
+
+// MarkConditions sets the supplied conditions on the supplied object.
+func (fn ManagerFn) MarkConditions(o resource.Object, c ...xpv1.Condition) {
+	fn(o, c...)
+}
+
+// An ObjectManager marks the conditions of any object that satisfies
+// resource.Conditioned, typically by embedding a ConditionedStatus.
+type ObjectManager struct{}
+
+// New returns a Manager that marks the conditions of any object that satisfies
+// resource.Conditioned.
+func New() ObjectManager {
+	return ObjectManager{}
+}
+
+// MarkConditions sets the supplied conditions on the supplied object. It does
+// nothing if the object does not satisfy resource.Conditioned.
+func (ObjectManager) MarkConditions(o resource.Object, c ...xpv1.Condition) {
+	if co, ok := o.(resource.Conditioned); ok {
+		co.SetConditions(c...)
+	}
+}
+
+// An ObservedGenerationManager sets the observed generation of conditions
+// before passing them to another Manager.
+type ObservedGenerationManager struct {
+	wrapped    Manager
+	generation func(o resource.Object) int64
+}
+
+// NewObservedGenerationManager returns a Manager that sets the observed
+// generation of conditions to the value returned by the supplied function for
+// the object they are set on, before passing them to the supplied Manager.
+func NewObservedGenerationManager(m Manager, fn func(o resource.Object) int64) *ObservedGenerationManager {
+	return &ObservedGenerationManager{wrapped: m, generation: fn}
+}
+
+// MarkConditions sets the observed generation of the supplied conditions, then
+// sets them on the supplied object.
+func (m *ObservedGenerationManager) MarkConditions(o resource.Object, c ...xpv1.Condition) {
+	gen := m.generation(o)
+	gc := make([]xpv1.Condition, len(c))
+	for i := range c {
+		gc[i] = c[i].WithObservedGeneration(gen)
+	}
+	m.wrapped.MarkConditions(o, gc...)
+}
+
+// A TruncatingManager truncates the messages of conditions before passing them
+// to another Manager.
+type TruncatingManager struct {
+	wrapped Manager
+	max     int
+}
+
+// NewTruncatingManager returns a Manager that truncates condition messages to
+// at most max bytes before passing them to the supplied Manager. Messages are
+// not truncated if max is zero or less.
+func NewTruncatingManager(m Manager, max int) *TruncatingManager {
+	return &TruncatingManager{wrapped: m, max: max}
+}
+
+// MarkConditions truncates the messages of the supplied conditions, then sets
+// them on the supplied object.
+func (m *TruncatingManager) MarkConditions(o resource.Object, c ...xpv1.Condition) {
+	tc := make([]xpv1.Condition, len(c))
+	for i := range c {
+		tc[i] = c[i].WithMessage(xpv1.TruncateMessage(c[i].Message, m.max))
+	}
+	m.wrapped.MarkConditions(o, tc...)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var _ Manager = ObjectManager{}
+var _ Manager = ManagerFn(nil)
+var _ Manager = &TruncatingManager{}
+var _ Manager = &ObservedGenerationManager{}
+
+func TestObjectManagerMarkConditions(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		o      resource.Object
+		c      []xpv1.Condition
+		want   resource.Object
+	}{
+		"Conditioned": {
+			reason: "Conditions should be set on objects that satisfy resource.Conditioned.",
+			o:      &fake.Managed{},
+			c:      []xpv1.Condition{xpv1.Available(), xpv1.ReconcileSuccess()},
+			want: func() resource.Object {
+				mg := &fake.Managed{}
+				mg.SetConditions(xpv1.Available(), xpv1.ReconcileSuccess())
+				return mg
+			}(),
+		},
+		"NotConditioned": {
+			reason: "Objects that do not satisfy resource.Conditioned should not be changed.",
+			o:      &corev1.Secret{},
+			c:      []xpv1.Condition{xpv1.Available()},
+			want:   &corev1.Secret{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			New().MarkConditions(tc.o, tc.c...)
+			if diff := cmp.Diff(tc.want, tc.o, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nMarkConditions(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTruncatingManagerMarkConditions(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		max    int
+		c      []xpv1.Condition
+		want   []xpv1.Condition
+	}{
+		"Truncated": {
+			reason: "Condition messages longer than the maximum should be truncated.",
+			max:    8,
+			c:      []xpv1.Condition{xpv1.Available(), xpv1.Unhealthy("very cool message")},
+			want:   []xpv1.Condition{xpv1.Available(), xpv1.Unhealthy("very …")},
+		},
+		"NoLimit": {
+			reason: "Condition messages should not be truncated if there is no maximum.",
+			max:    0,
+			c:      []xpv1.Condition{xpv1.Unhealthy("very cool message")},
+			want:   []xpv1.Condition{xpv1.Unhealthy("very cool message")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []xpv1.Condition
+			m := NewTruncatingManager(ManagerFn(func(_ resource.Object, c ...xpv1.Condition) { got = c }), tc.max)
+			m.MarkConditions(&fake.Managed{}, tc.c...)
+			if diff := cmp.Diff(tc.want, got, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nMarkConditions(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObservedGenerationManagerMarkConditions(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		o      resource.Object
+		fn     func(o resource.Object) int64
+		c      []xpv1.Condition
+		want   []xpv1.Condition
+	}{
+		"MetadataGeneration": {
+			reason: "Conditions should be marked with the generation returned by the supplied function.",
+			o:      &fake.Managed{ObjectMeta: metav1.ObjectMeta{Generation: 3}},
+			fn:     func(o resource.Object) int64 { return o.GetGeneration() },
+			c:      []xpv1.Condition{xpv1.Available(), xpv1.ReconcileSuccess()},
+			want:   []xpv1.Condition{xpv1.Available().WithObservedGeneration(3), xpv1.ReconcileSuccess().WithObservedGeneration(3)},
+		},
+		"CustomGeneration": {
+			reason: "Conditions should be marked with a generation that is not the object's metadata.generation.",
+			o:      &fake.Managed{ObjectMeta: metav1.ObjectMeta{Generation: 3}},
+			fn:     func(_ resource.Object) int64 { return 42 },
+			c:      []xpv1.Condition{xpv1.Available()},
+			want:   []xpv1.Condition{xpv1.Available().WithObservedGeneration(42)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []xpv1.Condition
+			m := NewObservedGenerationManager(ManagerFn(func(_ resource.Object, c ...xpv1.Condition) { got = c }), tc.fn)
+			m.MarkConditions(tc.o, tc.c...)
+			if diff := cmp.Diff(tc.want, got, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nMarkConditions(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
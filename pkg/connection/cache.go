@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+	"sync"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+)
+
+type cacheKey struct{}
+
+type secretCache struct {
+	mx      sync.Mutex
+	secrets map[store.ScopedName]*store.Secret
+}
+
+// WithSecretCache returns a copy of the supplied context with an empty secret
+// cache. Any CachingStore that is called with the returned context (or a
+// context derived from it) memoizes the secrets it reads in this cache. The
+// cache is discarded along with the context, so a new context should be
+// created for each reconcile in order to avoid reading stale secrets.
+func WithSecretCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheKey{}, &secretCache{secrets: make(map[store.ScopedName]*store.Secret)})
+}
+
+func secretCacheFrom(ctx context.Context) *secretCache {
+	c, _ := ctx.Value(cacheKey{}).(*secretCache)
+	return c
+}
+
+// A CachingStore is a Store that memoizes the secrets read from the Store it
+// wraps. Secrets are only cached when the CachingStore is called with a
+// context returned by WithSecretCache; a CachingStore called with any other
+// context reads through to the wrapped Store. Failed reads are never cached.
+type CachingStore struct {
+	inner Store
+}
+
+// NewCachingStore returns a CachingStore that wraps the supplied Store.
+func NewCachingStore(inner Store) *CachingStore {
+	return &CachingStore{inner: inner}
+}
+
+// ReadKeyValues reads the secret with the supplied name from the cache, or
+// from the wrapped Store if it has not yet been cached.
+func (c *CachingStore) ReadKeyValues(ctx context.Context, n store.ScopedName, s *store.Secret) error {
+	sc := secretCacheFrom(ctx)
+	if sc == nil {
+		return c.inner.ReadKeyValues(ctx, n, s)
+	}
+
+	sc.mx.Lock()
+	defer sc.mx.Unlock()
+
+	if cached, ok := sc.secrets[n]; ok {
+		copySecret(cached, s)
+		return nil
+	}
+
+	if err := c.inner.ReadKeyValues(ctx, n, s); err != nil {
+		return err
+	}
+
+	cached := &store.Secret{}
+	copySecret(s, cached)
+	sc.secrets[n] = cached
+	return nil
+}
+
+// WriteKeyValues writes the supplied secret to the wrapped Store, removing it
+// from the cache.
+func (c *CachingStore) WriteKeyValues(ctx context.Context, s *store.Secret, wo ...store.WriteOption) (bool, error) {
+	c.invalidate(ctx, s.ScopedName)
+	return c.inner.WriteKeyValues(ctx, s, wo...)
+}
+
+// DeleteKeyValues deletes the supplied secret from the wrapped Store, removing
+// it from the cache.
+func (c *CachingStore) DeleteKeyValues(ctx context.Context, s *store.Secret, do ...store.DeleteOption) error {
+	c.invalidate(ctx, s.ScopedName)
+	return c.inner.DeleteKeyValues(ctx, s, do...)
+}
+
+func (c *CachingStore) invalidate(ctx context.Context, n store.ScopedName) {
+	sc := secretCacheFrom(ctx)
+	if sc == nil {
+		return
+	}
+	sc.mx.Lock()
+	defer sc.mx.Unlock()
+	delete(sc.secrets, n)
+}
+
+// copySecret deep copies the data and metadata of the from secret into the to
+// secret, such that callers cannot modify cached secrets.
+func copySecret(from, to *store.Secret) {
+	to.Metadata = from.Metadata.DeepCopy()
+	to.Data = nil
+	if from.Data != nil {
+		to.Data = make(store.KeyValues, len(from.Data))
+		for k, v := range from.Data {
+			to.Data[k] = append([]byte(nil), v...)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestCachingStoreReadKeyValues(t *testing.T) {
+	errBoom := errors.New("boom")
+	n := store.ScopedName{Name: "cool", Scope: "ns"}
+
+	type want struct {
+		data  []store.KeyValues
+		errs  []error
+		reads int
+	}
+
+	cases := map[string]struct {
+		reason string
+		ctx    context.Context
+		err    error
+		write  bool
+		want   want
+	}{
+		"Cached": {
+			reason: "Secrets should only be read from the wrapped store once per cache context.",
+			ctx:    WithSecretCache(context.Background()),
+			want: want{
+				data:  []store.KeyValues{{"k": []byte("v")}, {"k": []byte("v")}},
+				errs:  []error{nil, nil},
+				reads: 1,
+			},
+		},
+		"NoCacheContext": {
+			reason: "Secrets should not be cached if the context has no secret cache.",
+			ctx:    context.Background(),
+			want: want{
+				data:  []store.KeyValues{{"k": []byte("v")}, {"k": []byte("v")}},
+				errs:  []error{nil, nil},
+				reads: 2,
+			},
+		},
+		"ErrorNotCached": {
+			reason: "Failed reads should not be cached.",
+			ctx:    WithSecretCache(context.Background()),
+			err:    errBoom,
+			want: want{
+				data:  []store.KeyValues{nil, nil},
+				errs:  []error{errBoom, errBoom},
+				reads: 2,
+			},
+		},
+		"WriteInvalidates": {
+			reason: "Writing a secret should remove it from the cache.",
+			ctx:    WithSecretCache(context.Background()),
+			write:  true,
+			want: want{
+				data:  []store.KeyValues{{"k": []byte("v")}, {"k": []byte("v")}},
+				errs:  []error{nil, nil},
+				reads: 2,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			reads := 0
+			c := NewCachingStore(&fake.SecretStore{
+				ReadKeyValuesFn: func(_ context.Context, _ store.ScopedName, s *store.Secret) error {
+					reads++
+					if tc.err != nil {
+						return tc.err
+					}
+					s.Data = store.KeyValues{"k": []byte("v")}
+					return nil
+				},
+				WriteKeyValuesFn: func(_ context.Context, _ *store.Secret, _ ...store.WriteOption) (bool, error) {
+					return true, nil
+				},
+			})
+
+			for i := range tc.want.data {
+				s := &store.Secret{}
+				err := c.ReadKeyValues(tc.ctx, n, s)
+				if diff := cmp.Diff(tc.want.errs[i], err, test.EquateErrors()); diff != "" {
+					t.Errorf("\n%s\nReadKeyValues(...) %d: -want error, +got error:\n%s", tc.reason, i, diff)
+				}
+				if diff := cmp.Diff(tc.want.data[i], s.Data); diff != "" {
+					t.Errorf("\n%s\nReadKeyValues(...) %d: -want, +got:\n%s", tc.reason, i, diff)
+				}
+
+				// Modifying the returned secret must not modify the cache.
+				if s.Data != nil {
+					s.Data["k"] = []byte("modified")
+				}
+
+				if tc.write {
+					_, _ = c.WriteKeyValues(tc.ctx, &store.Secret{ScopedName: n})
+				}
+			}
+
+			if diff := cmp.Diff(tc.want.reads, reads); diff != "" {
+				t.Errorf("\n%s\nReadKeyValues(...): -want reads, +got reads:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+const (
+	errFmtGetPluginConfig    = "cannot get %s %q"
+	errFmtDecodePluginConfig = "cannot decode %s %q"
+)
+
+// ResolvePluginConfig fetches the cluster scoped object referenced by the
+// supplied TypedReference and decodes it into the supplied object. It allows
+// a Store to load configuration of an arbitrary type without a typed client
+// for it. The returned error satisfies kerrors.IsNotFound if the referenced
+// object does not exist.
+func ResolvePluginConfig(ctx context.Context, c client.Reader, ref v1.TypedReference, out runtime.Object) error {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(ref.GroupVersionKind())
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, u); err != nil {
+		return errors.Wrapf(err, errFmtGetPluginConfig, ref.Kind, ref.Name)
+	}
+	return errors.Wrapf(runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, out), errFmtDecodePluginConfig, ref.Kind, ref.Name)
+}
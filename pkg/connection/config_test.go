@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestResolvePluginConfig(t *testing.T) {
+	errBoom := errors.New("boom")
+	ref := v1.TypedReference{APIVersion: "v1", Kind: "ConfigMap", Name: "cool"}
+	errNotFound := kerrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "cool")
+
+	type want struct {
+		out      *corev1.ConfigMap
+		err      error
+		notFound bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      client.Reader
+		want   want
+	}{
+		"GetError": {
+			reason: "Errors getting the referenced object should be returned.",
+			c:      &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want: want{
+				out: &corev1.ConfigMap{},
+				err: errors.Wrapf(errBoom, errFmtGetPluginConfig, "ConfigMap", "cool"),
+			},
+		},
+		"NotFound": {
+			reason: "A missing referenced object should return a not found error.",
+			c:      &test.MockClient{MockGet: test.NewMockGetFn(errNotFound)},
+			want: want{
+				out:      &corev1.ConfigMap{},
+				err:      errors.Wrapf(errNotFound, errFmtGetPluginConfig, "ConfigMap", "cool"),
+				notFound: true,
+			},
+		},
+		"Success": {
+			reason: "The referenced object should be decoded into the supplied object.",
+			c: &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				u := obj.(*unstructured.Unstructured)
+				if u.GroupVersionKind() != ref.GroupVersionKind() {
+					t.Errorf("Get(...): want GVK %s, got %s", ref.GroupVersionKind(), u.GroupVersionKind())
+				}
+				u.SetName("cool")
+				u.Object["data"] = map[string]any{"key": "value"}
+				return nil
+			})},
+			want: want{
+				out: &corev1.ConfigMap{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+					ObjectMeta: metav1.ObjectMeta{Name: "cool"},
+					Data:       map[string]string{"key": "value"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			out := &corev1.ConfigMap{}
+			err := ResolvePluginConfig(context.Background(), tc.c, ref, out)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nResolvePluginConfig(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.notFound, kerrors.IsNotFound(err)); diff != "" {
+				t.Errorf("\n%s\nkerrors.IsNotFound(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.out, out); diff != "" {
+				t.Errorf("\n%s\nResolvePluginConfig(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
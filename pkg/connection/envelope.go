@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+const (
+	errFmtEncrypt = "cannot encrypt key %q of secret %q"
+	errFmtDecrypt = "cannot decrypt key %q of secret %q"
+)
+
+// An Encrypter encrypts and decrypts secret values, typically using a key
+// managed by a key management service (KMS). Implementations should bind each
+// ciphertext to the supplied additional authenticated data (AAD), for example
+// by passing it to an AEAD cipher, such that Decrypt fails if it is not
+// supplied the AAD the ciphertext was encrypted with.
+type Encrypter interface {
+	// Encrypt the supplied plaintext, binding it to the supplied
+	// additional authenticated data.
+	Encrypt(ctx context.Context, plaintext, additionalData []byte) ([]byte, error)
+
+	// Decrypt the supplied ciphertext, which must have been encrypted with
+	// the supplied additional authenticated data.
+	Decrypt(ctx context.Context, ciphertext, additionalData []byte) ([]byte, error)
+}
+
+// An EnvelopeStore is a Store that encrypts the values of each secret before
+// writing them to the Store it wraps, and decrypts them after reading them.
+// Keys and metadata are passed through unchanged. Each value is encrypted with
+// additional authenticated data consisting of a JSON array of the scope and
+// name of its secret and its key, e.g. ["ns","cool","password"], so that a
+// ciphertext can't be decrypted after it is moved to a different key or secret.
+type EnvelopeStore struct {
+	inner     Store
+	encrypter Encrypter
+}
+
+// NewEnvelopeStore returns an EnvelopeStore that wraps the supplied Store,
+// using the supplied Encrypter to encrypt and decrypt secret values.
+func NewEnvelopeStore(inner Store, e Encrypter) *EnvelopeStore {
+	return &EnvelopeStore{inner: inner, encrypter: e}
+}
+
+// ReadKeyValues reads the secret with the supplied name from the wrapped Store
+// and decrypts its values. The secret's data is cleared if any value cannot be
+// decrypted.
+func (e *EnvelopeStore) ReadKeyValues(ctx context.Context, n store.ScopedName, s *store.Secret) error {
+	if err := e.inner.ReadKeyValues(ctx, n, s); err != nil {
+		return err
+	}
+	kv, err := transformKeyValues(ctx, n, s.Data, e.encrypter.Decrypt, errFmtDecrypt)
+	s.Data = kv
+	return err
+}
+
+// WriteKeyValues encrypts the values of the supplied secret and writes it to
+// the wrapped Store. The supplied secret is not modified. Note that any
+// WriteOptions are called by the wrapped Store, and thus see encrypted values.
+// Stores that compare the current and desired values of a secret in order to
+// determine whether it changed will report a change every time if the
+// Encrypter's output is not deterministic.
+func (e *EnvelopeStore) WriteKeyValues(ctx context.Context, s *store.Secret, wo ...store.WriteOption) (bool, error) {
+	kv, err := transformKeyValues(ctx, s.ScopedName, s.Data, e.encrypter.Encrypt, errFmtEncrypt)
+	if err != nil {
+		return false, err
+	}
+	return e.inner.WriteKeyValues(ctx, &store.Secret{ScopedName: s.ScopedName, Metadata: s.Metadata, Data: kv}, wo...)
+}
+
+// DeleteKeyValues deletes the supplied secret from the wrapped Store.
+func (e *EnvelopeStore) DeleteKeyValues(ctx context.Context, s *store.Secret, do ...store.DeleteOption) error {
+	return e.inner.DeleteKeyValues(ctx, s, do...)
+}
+
+func transformKeyValues(ctx context.Context, n store.ScopedName, in store.KeyValues, fn func(context.Context, []byte, []byte) ([]byte, error), errFmt string) (store.KeyValues, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make(store.KeyValues, len(in))
+	for k, v := range in {
+		t, err := fn(ctx, v, additionalData(n, k))
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmt, k, n.Name)
+		}
+		out[k] = t
+	}
+	return out, nil
+}
+
+// additionalData returns the additional authenticated data used to encrypt and
+// decrypt the value of the supplied key of the supplied secret. It's encoded as
+// JSON because some stores allow scopes that contain separators, like '/'.
+func additionalData(n store.ScopedName, key string) []byte {
+	// Marshalling a slice of strings can't fail.
+	ad, _ := json.Marshal([]string{n.Scope, n.Name, key})
+	return ad
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var _ Store = &EnvelopeStore{}
+
+var errAdditionalData = errors.New("additional data mismatch")
+
+// A prefixEncrypter "encrypts" values by prefixing them with "enc:" and the
+// additional data they are bound to.
+type prefixEncrypter struct {
+	err error
+}
+
+func (e prefixEncrypter) Encrypt(_ context.Context, p, ad []byte) ([]byte, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	return append([]byte("enc:"+string(ad)+":"), p...), nil
+}
+
+func (e prefixEncrypter) Decrypt(_ context.Context, c, ad []byte) ([]byte, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	prefix := "enc:" + string(ad) + ":"
+	if !strings.HasPrefix(string(c), prefix) {
+		return nil, errAdditionalData
+	}
+	return c[len(prefix):], nil
+}
+
+func TestEnvelopeStoreWriteKeyValues(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		written store.KeyValues
+		changed bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason    string
+		encrypter Encrypter
+		writeErr  error
+		want      want
+	}{
+		"EncryptError": {
+			reason:    "Errors encrypting a value should be returned.",
+			encrypter: prefixEncrypter{err: errBoom},
+			want:      want{err: errors.Wrapf(errBoom, errFmtEncrypt, "k", "cool")},
+		},
+		"WriteError": {
+			reason:    "Errors writing the secret should be returned.",
+			encrypter: prefixEncrypter{},
+			writeErr:  errBoom,
+			want:      want{written: store.KeyValues{"k": []byte(`enc:["ns","cool","k"]:v`)}, err: errBoom},
+		},
+		"Success": {
+			reason:    "Values should be encrypted before they are written, and keys preserved.",
+			encrypter: prefixEncrypter{},
+			want:      want{written: store.KeyValues{"k": []byte(`enc:["ns","cool","k"]:v`)}, changed: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := &store.Secret{
+				ScopedName: store.ScopedName{Name: "cool", Scope: "ns"},
+				Data:       store.KeyValues{"k": []byte("v")},
+			}
+
+			var written store.KeyValues
+			e := NewEnvelopeStore(&fake.SecretStore{
+				WriteKeyValuesFn: func(_ context.Context, s *store.Secret, _ ...store.WriteOption) (bool, error) {
+					written = s.Data
+					return tc.writeErr == nil, tc.writeErr
+				},
+			}, tc.encrypter)
+
+			changed, err := e.WriteKeyValues(context.Background(), s)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nWriteKeyValues(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("\n%s\nWriteKeyValues(...): -want changed, +got changed:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.written, written); diff != "" {
+				t.Errorf("\n%s\nWriteKeyValues(...): -want written, +got written:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(store.KeyValues{"k": []byte("v")}, s.Data); diff != "" {
+				t.Errorf("\n%s\nWriteKeyValues(...): the supplied secret should not be modified: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnvelopeStoreReadKeyValues(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		data store.KeyValues
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason    string
+		encrypter Encrypter
+		data      store.KeyValues
+		readErr   error
+		want      want
+	}{
+		"ReadError": {
+			reason:    "Errors reading the secret should be returned.",
+			encrypter: prefixEncrypter{},
+			readErr:   errBoom,
+			want:      want{err: errBoom},
+		},
+		"DecryptError": {
+			reason:    "Errors decrypting a value should be returned.",
+			encrypter: prefixEncrypter{err: errBoom},
+			data:      store.KeyValues{"k": []byte(`enc:["ns","cool","k"]:v`)},
+			want:      want{err: errors.Wrapf(errBoom, errFmtDecrypt, "k", "cool")},
+		},
+		"MovedKey": {
+			reason:    "A value encrypted for a different key should not be decrypted.",
+			encrypter: prefixEncrypter{},
+			data:      store.KeyValues{"k": []byte(`enc:["ns","cool","other"]:v`)},
+			want:      want{err: errors.Wrapf(errAdditionalData, errFmtDecrypt, "k", "cool")},
+		},
+		"MovedSecret": {
+			reason:    "A value encrypted for a different secret should not be decrypted.",
+			encrypter: prefixEncrypter{},
+			data:      store.KeyValues{"k": []byte(`enc:["other","cool","k"]:v`)},
+			want:      want{err: errors.Wrapf(errAdditionalData, errFmtDecrypt, "k", "cool")},
+		},
+		"Success": {
+			reason:    "Values should be decrypted after they are read, and keys preserved.",
+			encrypter: prefixEncrypter{},
+			data:      store.KeyValues{"k": []byte(`enc:["ns","cool","k"]:v`)},
+			want:      want{data: store.KeyValues{"k": []byte("v")}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := NewEnvelopeStore(&fake.SecretStore{
+				ReadKeyValuesFn: func(_ context.Context, _ store.ScopedName, s *store.Secret) error {
+					if tc.readErr != nil {
+						return tc.readErr
+					}
+					s.Data = tc.data
+					return nil
+				},
+			}, tc.encrypter)
+
+			s := &store.Secret{}
+			err := e.ReadKeyValues(context.Background(), store.ScopedName{Name: "cool", Scope: "ns"}, s)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nReadKeyValues(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.data, s.Data); diff != "" {
+				t.Errorf("\n%s\nReadKeyValues(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Error strings.
+const (
+	errFmtPublish   = "cannot publish connection details using publisher %d"
+	errFmtUnpublish = "cannot unpublish connection details using publisher %d"
+)
+
+// A MultiPublisher publishes connection details using multiple publishers,
+// for example to mirror connection details to more than one secret store.
+// Unlike a managed.PublisherChain, a MultiPublisher calls every publisher even
+// if an earlier publisher returns an error.
+type MultiPublisher []managed.ConnectionPublisher
+
+// PublishConnection calls each ConnectionPublisher.PublishConnection in order.
+// It returns true if any publisher published connection details, and an
+// aggregate of any errors encountered.
+func (mp MultiPublisher) PublishConnection(ctx context.Context, so resource.ConnectionSecretOwner, c managed.ConnectionDetails) (bool, error) {
+	published := false
+	errs := make([]error, 0)
+	for i, p := range mp {
+		pb, err := p.PublishConnection(ctx, so, c)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, errFmtPublish, i))
+			continue
+		}
+		if pb {
+			published = true
+		}
+	}
+	return published, kerrors.NewAggregate(errs)
+}
+
+// UnpublishConnection calls each ConnectionPublisher.UnpublishConnection in
+// order. It returns an aggregate of any errors encountered.
+func (mp MultiPublisher) UnpublishConnection(ctx context.Context, so resource.ConnectionSecretOwner, c managed.ConnectionDetails) error {
+	errs := make([]error, 0)
+	for i, p := range mp {
+		if err := p.UnpublishConnection(ctx, so, c); err != nil {
+			errs = append(errs, errors.Wrapf(err, errFmtUnpublish, i))
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
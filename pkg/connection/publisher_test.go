@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	resourcefake "github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var _ managed.ConnectionPublisher = MultiPublisher{}
+
+func TestMultiPublisherPublishConnection(t *testing.T) {
+	type want struct {
+		published bool
+		err       error
+		calls     int
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      func(calls *int) MultiPublisher
+		want   want
+	}{
+		"EmptyMultiPublisher": {
+			reason: "An empty MultiPublisher should publish nothing and return no error.",
+			p:      func(_ *int) MultiPublisher { return MultiPublisher{} },
+		},
+		"SuccessfulPublish": {
+			reason: "A MultiPublisher should report connection details as published if any publisher published them.",
+			p: func(calls *int) MultiPublisher {
+				return MultiPublisher{
+					publisher(calls, false, nil),
+					publisher(calls, true, nil),
+				}
+			},
+			want: want{
+				published: true,
+				calls:     2,
+			},
+		},
+		"PublishError": {
+			reason: "A MultiPublisher should call every publisher and return an aggregate of any errors.",
+			p: func(calls *int) MultiPublisher {
+				return MultiPublisher{
+					publisher(calls, false, errBoom),
+					publisher(calls, true, nil),
+					publisher(calls, false, errBoom),
+				}
+			},
+			want: want{
+				published: true,
+				err: kerrors.NewAggregate([]error{
+					errors.Wrapf(errBoom, errFmtPublish, 0),
+					errors.Wrapf(errBoom, errFmtPublish, 2),
+				}),
+				calls: 3,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			got, err := tc.p(&calls).PublishConnection(context.Background(), &resourcefake.Managed{}, managed.ConnectionDetails{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nPublishConnection(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.published, got); diff != "" {
+				t.Errorf("\n%s\nPublishConnection(...): -want published, +got published:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\nPublishConnection(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestMultiPublisherUnpublishConnection(t *testing.T) {
+	type want struct {
+		err   error
+		calls int
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      func(calls *int) MultiPublisher
+		want   want
+	}{
+		"SuccessfulUnpublish": {
+			reason: "A MultiPublisher should call every publisher and return no error if all succeed.",
+			p: func(calls *int) MultiPublisher {
+				return MultiPublisher{
+					publisher(calls, false, nil),
+					publisher(calls, false, nil),
+				}
+			},
+			want: want{
+				calls: 2,
+			},
+		},
+		"UnpublishError": {
+			reason: "A MultiPublisher should call every publisher and return an aggregate of any errors.",
+			p: func(calls *int) MultiPublisher {
+				return MultiPublisher{
+					publisher(calls, false, errBoom),
+					publisher(calls, false, nil),
+				}
+			},
+			want: want{
+				err:   kerrors.NewAggregate([]error{errors.Wrapf(errBoom, errFmtUnpublish, 0)}),
+				calls: 2,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			err := tc.p(&calls).UnpublishConnection(context.Background(), &resourcefake.Managed{}, managed.ConnectionDetails{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUnpublishConnection(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\nUnpublishConnection(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func publisher(calls *int, published bool, err error) managed.ConnectionPublisher {
+	return managed.ConnectionPublisherFns{
+		PublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, _ managed.ConnectionDetails) (bool, error) {
+			*calls++
+			return published, err
+		},
+		UnpublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, _ managed.ConnectionDetails) error {
+			*calls++
+			return err
+		},
+	}
+}
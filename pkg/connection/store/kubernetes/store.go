@@ -18,6 +18,7 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -47,6 +48,20 @@ const (
 	errBuildClient                = "cannot build Kubernetes client"
 )
 
+// An ErrConnectionDetailsTooLarge is returned when the connection details to be
+// written to a Kubernetes Secret exceed the maximum size of a Secret.
+type ErrConnectionDetailsTooLarge struct {
+	// Size is the total size of the connection detail values, in bytes.
+	Size int
+
+	// Limit is the maximum size of a Kubernetes Secret, in bytes.
+	Limit int
+}
+
+func (e *ErrConnectionDetailsTooLarge) Error() string {
+	return fmt.Sprintf("connection details total %d bytes, which exceeds the Kubernetes Secret size limit of %d bytes", e.Size, e.Limit)
+}
+
 // SecretStore is a Kubernetes Secret Store.
 type SecretStore struct {
 	client resource.ClientApplicator
@@ -105,6 +120,13 @@ func (ss *SecretStore) ReadKeyValues(ctx context.Context, n store.ScopedName, s
 
 // WriteKeyValues writes key value pairs to a given Kubernetes Secret.
 func (ss *SecretStore) WriteKeyValues(ctx context.Context, s *store.Secret, wo ...store.WriteOption) (bool, error) {
+	// The API server would reject a Secret this large anyway, but with an
+	// error that doesn't tell the user how large their connection details
+	// are.
+	if sz := dataSize(s.Data); sz > corev1.MaxSecretSize {
+		return false, &ErrConnectionDetailsTooLarge{Size: sz, Limit: corev1.MaxSecretSize}
+	}
+
 	ks := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      s.Name,
@@ -183,6 +205,14 @@ func (ss *SecretStore) DeleteKeyValues(ctx context.Context, s *store.Secret, do
 	return errors.Wrapf(ss.client.Update(ctx, ks), errUpdateSecret)
 }
 
+func dataSize(kv store.KeyValues) int {
+	sz := 0
+	for _, v := range kv {
+		sz += len(v)
+	}
+	return sz
+}
+
 func (ss *SecretStore) namespaceForSecret(n store.ScopedName) string {
 	if n.Scope == "" {
 		return ss.defaultNamespace
@@ -175,6 +175,29 @@ func TestSecretStoreWriteKeyValues(t *testing.T) {
 				err: errors.Wrap(errBoom, errApplySecret),
 			},
 		},
+		"ConnectionDetailsTooLarge": {
+			reason: "Should return a proper error without attempting to apply if the connection details are too large.",
+			args: args{
+				client: resource.ClientApplicator{
+					Applicator: resource.ApplyFn(func(ctx context.Context, obj client.Object, option ...resource.ApplyOption) error {
+						return errBoom
+					}),
+				},
+				secret: &store.Secret{
+					ScopedName: store.ScopedName{
+						Name:  fakeSecretName,
+						Scope: fakeSecretNamespace,
+					},
+					Data: store.KeyValues(map[string][]byte{
+						"key1": make([]byte, corev1.MaxSecretSize),
+						"key2": []byte("value2"),
+					}),
+				},
+			},
+			want: want{
+				err: &ErrConnectionDetailsTooLarge{Size: corev1.MaxSecretSize + 6, Limit: corev1.MaxSecretSize},
+			},
+		},
 		"FailedWriteOption": {
 			reason: "Should return a proper error if supplied write option fails",
 			args: args{
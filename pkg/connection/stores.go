@@ -18,7 +18,9 @@ package connection
 
 import (
 	"context"
+	"sync"
 
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -29,18 +31,106 @@ import (
 
 const (
 	errFmtUnknownSecretStore = "unknown secret store type: %q"
+	errNoStoreConfigRef      = "no secret store config reference"
+	errFmtGetStoreConfig     = "cannot get secret store config %q"
 )
 
 // RuntimeStoreBuilder builds and returns a Store for any supported Store type
 // in a given config.
 //
-// All in-tree connection Store implementations needs to be registered here.
+// All in-tree connection Store implementations needs to be registered with
+// NewStoreBuilderRegistry.
 func RuntimeStoreBuilder(ctx context.Context, local client.Client, cfg v1.SecretStoreConfig) (Store, error) {
-	switch *cfg.Type {
-	case v1.SecretStoreKubernetes:
+	return NewStoreBuilderRegistry().NewStore(ctx, local, cfg)
+}
+
+// A StoreBuilderRegistry builds Stores by dispatching to the StoreBuilderFn
+// registered for the type of the supplied SecretStoreConfig. It allows types of
+// Store that are not implemented in-tree to be plugged in at runtime. A
+// StoreBuilderRegistry is safe for concurrent use.
+type StoreBuilderRegistry struct {
+	mx       sync.RWMutex
+	builders map[v1.SecretStoreType]StoreBuilderFn
+}
+
+// NewStoreBuilderRegistry returns a StoreBuilderRegistry with all in-tree Store
+// implementations registered.
+func NewStoreBuilderRegistry() *StoreBuilderRegistry {
+	r := &StoreBuilderRegistry{builders: map[v1.SecretStoreType]StoreBuilderFn{}}
+	r.Register(v1.SecretStoreKubernetes, func(ctx context.Context, local client.Client, cfg v1.SecretStoreConfig) (Store, error) {
 		return kubernetes.NewSecretStore(ctx, local, cfg)
-	case v1.SecretStoreVault:
+	})
+	r.Register(v1.SecretStoreVault, func(ctx context.Context, local client.Client, cfg v1.SecretStoreConfig) (Store, error) {
 		return vault.NewSecretStore(ctx, local, cfg)
+	})
+	return r
+}
+
+// Register the supplied StoreBuilderFn for the supplied type of store,
+// replacing any StoreBuilderFn previously registered for that type.
+func (r *StoreBuilderRegistry) Register(t v1.SecretStoreType, b StoreBuilderFn) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	r.builders[t] = b
+}
+
+// NewStore builds and returns a Store using the StoreBuilderFn registered for
+// the type of the supplied config. The Kubernetes type is assumed if the config
+// does not specify a type. NewStore satisfies StoreBuilderFn, and may be
+// supplied to a DetailsManager using WithStoreBuilder.
+func (r *StoreBuilderRegistry) NewStore(ctx context.Context, local client.Client, cfg v1.SecretStoreConfig) (Store, error) {
+	t := v1.SecretStoreKubernetes
+	if cfg.Type != nil {
+		t = *cfg.Type
+	}
+
+	r.mx.RLock()
+	b, ok := r.builders[t]
+	r.mx.RUnlock()
+
+	if !ok {
+		return nil, errors.Errorf(errFmtUnknownSecretStore, t)
 	}
-	return nil, errors.Errorf(errFmtUnknownSecretStore, *cfg.Type)
+	return b(ctx, local, cfg)
+}
+
+// ValidatePublishTarget returns an error if the supplied connection details
+// publishing target refers to a secret store config that does not exist, or
+// that is of a type for which no StoreBuilderFn is registered. The supplied
+// StoreConfig is used to get the referenced config, and should be an empty
+// instance of the kind used by the caller's DetailsManager. It is suitable for
+// rejecting invalid targets at admission time, rather than failing to publish
+// connection details while reconciling.
+func (r *StoreBuilderRegistry) ValidatePublishTarget(ctx context.Context, c client.Reader, sc StoreConfig, p *v1.PublishConnectionDetailsTo) error {
+	if p == nil {
+		return nil
+	}
+	if p.SecretStoreConfigRef == nil {
+		return errors.New(errNoStoreConfigRef)
+	}
+	if err := c.Get(ctx, types.NamespacedName{Name: p.SecretStoreConfigRef.Name}, sc); err != nil {
+		return errors.Wrapf(err, errFmtGetStoreConfig, p.SecretStoreConfigRef.Name)
+	}
+
+	t := v1.SecretStoreKubernetes
+	if cfg := sc.GetStoreConfig(); cfg.Type != nil {
+		t = *cfg.Type
+	}
+
+	r.mx.RLock()
+	_, ok := r.builders[t]
+	r.mx.RUnlock()
+
+	if !ok {
+		return errors.Errorf(errFmtUnknownSecretStore, t)
+	}
+	return nil
+}
+
+// ValidatePublishTarget returns an error if the supplied connection details
+// publishing target refers to a secret store config that does not exist, or
+// that is of a type not supported in-tree. See
+// StoreBuilderRegistry.ValidatePublishTarget.
+func ValidatePublishTarget(ctx context.Context, c client.Reader, sc StoreConfig, p *v1.PublishConnectionDetailsTo) error {
+	return NewStoreBuilderRegistry().ValidatePublishTarget(ctx, c, sc, p)
 }
@@ -0,0 +1,213 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store/kubernetes"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestStoreBuilderRegistryNewStore(t *testing.T) {
+	ss := &fake.SecretStore{}
+	unknown := v1.SecretStoreType("Unknown")
+	kube := v1.SecretStoreKubernetes
+
+	type args struct {
+		register map[v1.SecretStoreType]StoreBuilderFn
+		cfg      v1.SecretStoreConfig
+	}
+
+	type want struct {
+		s   Store
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"UnknownType": {
+			reason: "We should return an error if no StoreBuilderFn is registered for the config's type.",
+			args: args{
+				cfg: v1.SecretStoreConfig{Type: &unknown},
+			},
+			want: want{
+				err: errors.Errorf(errFmtUnknownSecretStore, unknown),
+			},
+		},
+		"RegisteredType": {
+			reason: "We should build a Store using the StoreBuilderFn registered for the config's type.",
+			args: args{
+				register: map[v1.SecretStoreType]StoreBuilderFn{
+					fakeStore: func(_ context.Context, _ client.Client, _ v1.SecretStoreConfig) (Store, error) {
+						return ss, nil
+					},
+				},
+				cfg: v1.SecretStoreConfig{Type: &fakeStore},
+			},
+			want: want{
+				s: ss,
+			},
+		},
+		"BuildError": {
+			reason: "We should return any error encountered while building the Store.",
+			args: args{
+				register: map[v1.SecretStoreType]StoreBuilderFn{
+					fakeStore: func(_ context.Context, _ client.Client, _ v1.SecretStoreConfig) (Store, error) {
+						return nil, errBoom
+					},
+				},
+				cfg: v1.SecretStoreConfig{Type: &fakeStore},
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+		"OverrideBuiltIn": {
+			reason: "A registered StoreBuilderFn should replace an in-tree one.",
+			args: args{
+				register: map[v1.SecretStoreType]StoreBuilderFn{
+					kube: func(_ context.Context, _ client.Client, _ v1.SecretStoreConfig) (Store, error) {
+						return ss, nil
+					},
+				},
+				cfg: v1.SecretStoreConfig{Type: &kube},
+			},
+			want: want{
+				s: ss,
+			},
+		},
+		"DefaultType": {
+			reason: "We should assume the Kubernetes type if none is specified.",
+			args: args{
+				register: map[v1.SecretStoreType]StoreBuilderFn{
+					kube: func(_ context.Context, _ client.Client, _ v1.SecretStoreConfig) (Store, error) {
+						return ss, nil
+					},
+				},
+				cfg: v1.SecretStoreConfig{},
+			},
+			want: want{
+				s: ss,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewStoreBuilderRegistry()
+			for st, b := range tc.args.register {
+				r.Register(st, b)
+			}
+
+			s, err := r.NewStore(context.Background(), &test.MockClient{}, tc.args.cfg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nr.NewStore(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if s != tc.want.s {
+				t.Errorf("\n%s\nr.NewStore(...): want store %v, got %v", tc.reason, tc.want.s, s)
+			}
+		})
+	}
+}
+
+func TestStoreBuilderRegistryBuiltIn(t *testing.T) {
+	kube := v1.SecretStoreKubernetes
+
+	s, err := NewStoreBuilderRegistry().NewStore(context.Background(), &test.MockClient{}, v1.SecretStoreConfig{Type: &kube})
+	if err != nil {
+		t.Fatalf("NewStoreBuilderRegistry().NewStore(...): %s", err)
+	}
+	if _, ok := s.(*kubernetes.SecretStore); !ok {
+		t.Errorf("NewStoreBuilderRegistry().NewStore(...): want *kubernetes.SecretStore, got %T", s)
+	}
+}
+
+func TestValidatePublishTarget(t *testing.T) {
+	errBoom := errors.New("boom")
+	unknown := v1.SecretStoreType("Unknown")
+	vault := v1.SecretStoreVault
+
+	withType := func(st *v1.SecretStoreType) test.ObjectFn {
+		return func(obj client.Object) error {
+			obj.(*fake.StoreConfig).Config.Type = st
+			return nil
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      client.Reader
+		p      *v1.PublishConnectionDetailsTo
+		want   error
+	}{
+		"NoTarget": {
+			reason: "We should not return an error if connection details are not published.",
+			c:      &test.MockClient{},
+			want:   nil,
+		},
+		"NoStoreConfigRef": {
+			reason: "We should return an error if the target does not reference a secret store config.",
+			c:      &test.MockClient{},
+			p:      &v1.PublishConnectionDetailsTo{Name: "cool"},
+			want:   errors.New(errNoStoreConfigRef),
+		},
+		"GetStoreConfigError": {
+			reason: "We should return an error if the referenced secret store config cannot be found.",
+			c:      &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			p:      &v1.PublishConnectionDetailsTo{Name: "cool", SecretStoreConfigRef: &v1.Reference{Name: "default"}},
+			want:   errors.Wrapf(errBoom, errFmtGetStoreConfig, "default"),
+		},
+		"UnknownType": {
+			reason: "We should return an error if the referenced secret store config is of an unsupported type.",
+			c:      &test.MockClient{MockGet: test.NewMockGetFn(nil, withType(&unknown))},
+			p:      &v1.PublishConnectionDetailsTo{Name: "cool", SecretStoreConfigRef: &v1.Reference{Name: "default"}},
+			want:   errors.Errorf(errFmtUnknownSecretStore, unknown),
+		},
+		"DefaultType": {
+			reason: "We should not return an error if the referenced secret store config does not specify a type.",
+			c:      &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			p:      &v1.PublishConnectionDetailsTo{Name: "cool", SecretStoreConfigRef: &v1.Reference{Name: "default"}},
+			want:   nil,
+		},
+		"SupportedType": {
+			reason: "We should not return an error if the referenced secret store config is of a supported type.",
+			c:      &test.MockClient{MockGet: test.NewMockGetFn(nil, withType(&vault))},
+			p:      &v1.PublishConnectionDetailsTo{Name: "cool", SecretStoreConfigRef: &v1.Reference{Name: "default"}},
+			want:   nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidatePublishTarget(context.Background(), tc.c, &fake.StoreConfig{}, tc.p)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidatePublishTarget(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+const (
+	errReadVerify      = "cannot read secret to verify it was written"
+	errFmtInconsistent = "key %q of secret %q"
+	errFmtMissingKey   = "key %q of secret %q is missing"
+)
+
+// ErrStoreInconsistent is returned by a VerifyingStore when the data it reads
+// back from a store differs from the data it wrote.
+var ErrStoreInconsistent = errors.New("secret store returned different data than was written")
+
+// A VerifyingStore is a Store that reads back each secret it writes in order to
+// verify that the Store it wraps persisted it. This doubles the number of calls
+// made to the wrapped Store when writing secrets, but is useful for catching
+// stores that are eventually consistent or otherwise misbehave.
+type VerifyingStore struct {
+	inner Store
+}
+
+// NewVerifyingStore returns a VerifyingStore that wraps the supplied Store.
+func NewVerifyingStore(inner Store) *VerifyingStore {
+	return &VerifyingStore{inner: inner}
+}
+
+// ReadKeyValues reads the secret with the supplied name from the wrapped Store.
+func (v *VerifyingStore) ReadKeyValues(ctx context.Context, n store.ScopedName, s *store.Secret) error {
+	return v.inner.ReadKeyValues(ctx, n, s)
+}
+
+// WriteKeyValues writes the supplied secret to the wrapped Store, then reads it
+// back. It returns an error wrapping ErrStoreInconsistent if any key it wrote
+// is missing or has a different value when read back. Keys it did not write
+// are ignored, because some stores merge the written keys with existing ones.
+func (v *VerifyingStore) WriteKeyValues(ctx context.Context, s *store.Secret, wo ...store.WriteOption) (bool, error) {
+	changed, err := v.inner.WriteKeyValues(ctx, s, wo...)
+	if err != nil {
+		return changed, err
+	}
+
+	got := &store.Secret{}
+	if err := v.inner.ReadKeyValues(ctx, s.ScopedName, got); err != nil {
+		return changed, errors.Wrap(err, errReadVerify)
+	}
+	return changed, verifyKeyValues(s.Name, s.Data, got.Data)
+}
+
+// DeleteKeyValues deletes the supplied secret from the wrapped Store.
+func (v *VerifyingStore) DeleteKeyValues(ctx context.Context, s *store.Secret, do ...store.DeleteOption) error {
+	return v.inner.DeleteKeyValues(ctx, s, do...)
+}
+
+func verifyKeyValues(name string, want, got store.KeyValues) error {
+	keys := make([]string, 0, len(want))
+	for k := range want {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		g, ok := got[k]
+		if !ok {
+			return errors.Wrapf(ErrStoreInconsistent, errFmtMissingKey, k, name)
+		}
+		if !bytes.Equal(want[k], g) {
+			return errors.Wrapf(ErrStoreInconsistent, errFmtInconsistent, k, name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var _ Store = &VerifyingStore{}
+
+func TestVerifyingStoreWriteKeyValues(t *testing.T) {
+	errBoom := errors.New("boom")
+	s := &store.Secret{
+		ScopedName: store.ScopedName{Name: "cool", Scope: "ns"},
+		Data:       store.KeyValues{"k": []byte("v")},
+	}
+
+	type want struct {
+		changed bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		writeErr error
+		readErr  error
+		read     store.KeyValues
+		want     want
+	}{
+		"WriteError": {
+			reason:   "Errors writing the secret should be returned.",
+			writeErr: errBoom,
+			want:     want{err: errBoom},
+		},
+		"ReadError": {
+			reason:  "Errors reading the secret back should be returned.",
+			readErr: errBoom,
+			want:    want{changed: true, err: errors.Wrap(errBoom, errReadVerify)},
+		},
+		"MissingKey": {
+			reason: "A written key that is missing when read back should be reported as inconsistent.",
+			read:   store.KeyValues{},
+			want:   want{changed: true, err: errors.Wrapf(ErrStoreInconsistent, errFmtMissingKey, "k", "cool")},
+		},
+		"DifferentValue": {
+			reason: "A written key with a different value when read back should be reported as inconsistent.",
+			read:   store.KeyValues{"k": []byte("stale")},
+			want:   want{changed: true, err: errors.Wrapf(ErrStoreInconsistent, errFmtInconsistent, "k", "cool")},
+		},
+		"Consistent": {
+			reason: "No error should be returned if the written keys are read back, even alongside other keys.",
+			read:   store.KeyValues{"k": []byte("v"), "other": []byte("v")},
+			want:   want{changed: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := NewVerifyingStore(&fake.SecretStore{
+				WriteKeyValuesFn: func(_ context.Context, _ *store.Secret, _ ...store.WriteOption) (bool, error) {
+					return tc.writeErr == nil, tc.writeErr
+				},
+				ReadKeyValuesFn: func(_ context.Context, _ store.ScopedName, s *store.Secret) error {
+					s.Data = tc.read
+					return tc.readErr
+				},
+			})
+
+			changed, err := v.WriteKeyValues(context.Background(), s)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nWriteKeyValues(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("\n%s\nWriteKeyValues(...): -want changed, +got changed:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
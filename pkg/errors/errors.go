@@ -22,6 +22,7 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // New returns an error that formats as the given text. Each call to New returns
@@ -126,3 +127,84 @@ func Cause(err error) error {
 
 	return err
 }
+
+// Find returns the first error in err's chain for which the supplied match
+// function returns true, or nil if no error matches. The chain consists of err
+// itself followed by the sequence of errors obtained by repeatedly calling
+// Unwrap.
+func Find(err error, match func(error) bool) error {
+	for err != nil {
+		if match(err) {
+			return err
+		}
+		err = errors.Unwrap(err)
+	}
+	return nil
+}
+
+// A MultiError aggregates multiple errors, for example the errors encountered
+// while performing a batch of operations. Is and As consider a MultiError to
+// match a target if any of the errors it contains matches the target.
+type MultiError struct {
+	errs []error
+}
+
+// NewMultiError returns an empty MultiError.
+func NewMultiError() *MultiError {
+	return &MultiError{}
+}
+
+// Add the supplied error to the MultiError. Nil errors are ignored.
+func (m *MultiError) Add(err error) {
+	if err == nil {
+		return
+	}
+	m.errs = append(m.errs, err)
+}
+
+// Errors returns the errors contained by the MultiError.
+func (m *MultiError) Errors() []error {
+	return m.errs
+}
+
+// ErrorOrNil returns the MultiError if it contains any errors, or nil if it
+// does not.
+func (m *MultiError) ErrorOrNil() error {
+	if len(m.errs) == 0 {
+		return nil
+	}
+	return m
+}
+
+// Error returns a message combining the messages of all contained errors.
+func (m *MultiError) Error() string {
+	if len(m.errs) == 1 {
+		return m.errs[0].Error()
+	}
+	msgs := make([]string, len(m.errs))
+	for i, err := range m.errs {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors occurred: [%s]", len(m.errs), strings.Join(msgs, ", "))
+}
+
+// Is returns true if any contained error matches the supplied target.
+func (m *MultiError) Is(target error) bool {
+	for _, err := range m.errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first contained error that matches the supplied target, and if
+// so sets target to that error value and returns true.
+func (m *MultiError) As(target any) bool {
+	for _, err := range m.errs {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -124,3 +124,116 @@ func TestCause(t *testing.T) {
 		})
 	}
 }
+
+type errCool struct{ error }
+
+func TestFind(t *testing.T) {
+	isCool := func(err error) bool {
+		_, ok := err.(errCool) //nolint:errorlint // We want to check each error in the chain.
+		return ok
+	}
+
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"NilError": {
+			err:  nil,
+			want: nil,
+		},
+		"NoMatch": {
+			err:  Wrap(New("boom"), "very useful context"),
+			want: nil,
+		},
+		"Match": {
+			err:  errCool{New("cool")},
+			want: errCool{New("cool")},
+		},
+		"WrappedMatch": {
+			err:  Wrap(Wrap(errCool{New("cool")}, "interstitial context"), "very important context"),
+			want: errCool{New("cool")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Find(tc.err, isCool)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("Find(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMultiError(t *testing.T) {
+	errBoom := New("boom")
+	errBang := New("bang")
+	cool := errCool{New("cool")}
+
+	type want struct {
+		msg    string
+		errs   []error
+		isBoom bool
+		asCool bool
+	}
+	cases := map[string]struct {
+		reason string
+		errs   []error
+		want   want
+	}{
+		"Empty": {
+			reason: "A MultiError with no errors should be nil.",
+		},
+		"NilErrors": {
+			reason: "Nil errors should not be added to a MultiError.",
+			errs:   []error{nil, nil},
+		},
+		"SingleError": {
+			reason: "A MultiError with one error should use that error's message.",
+			errs:   []error{Wrap(errBoom, "context")},
+			want: want{
+				msg:    "context: boom",
+				errs:   []error{Wrap(errBoom, "context")},
+				isBoom: true,
+			},
+		},
+		"MultipleErrors": {
+			reason: "A MultiError with many errors should combine their messages, and match any of them.",
+			errs:   []error{errBang, nil, Wrap(cool, "context"), errBoom},
+			want: want{
+				msg:    "3 errors occurred: [bang, context: cool, boom]",
+				errs:   []error{errBang, Wrap(cool, "context"), errBoom},
+				isBoom: true,
+				asCool: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := NewMultiError()
+			for _, err := range tc.errs {
+				m.Add(err)
+			}
+			err := m.ErrorOrNil()
+			if diff := cmp.Diff(tc.want.msg == "", err == nil); diff != "" {
+				t.Errorf("\n%s\nm.ErrorOrNil(): -want nil, +got nil:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				if diff := cmp.Diff(tc.want.msg, err.Error()); diff != "" {
+					t.Errorf("\n%s\nm.Error(): -want, +got:\n%s", tc.reason, diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.errs, m.Errors(), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nm.Errors(): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.isBoom, Is(err, errBoom)); diff != "" {
+				t.Errorf("\n%s\nIs(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			target := errCool{}
+			if diff := cmp.Diff(tc.want.asCool, As(err, &target)); diff != "" {
+				t.Errorf("\n%s\nAs(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
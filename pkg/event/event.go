@@ -18,7 +18,12 @@ limitations under the License.
 package event
 
 import (
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 )
 
@@ -121,3 +126,90 @@ func (r *NopRecorder) Event(_ runtime.Object, _ Event) {}
 
 // WithAnnotations does nothing.
 func (r *NopRecorder) WithAnnotations(_ ...string) Recorder { return r }
+
+// A DedupRecorder suppresses duplicate events. An event is considered a
+// duplicate if an event of the same type, reason, and message was recorded for
+// the same object within the deduplication window.
+type DedupRecorder struct {
+	inner  Recorder
+	window time.Duration
+	seen   *seenEvents
+}
+
+type dedupKey struct {
+	uid     types.UID
+	typ     Type
+	reason  Reason
+	message string
+}
+
+type seenEvents struct {
+	mx        sync.Mutex
+	now       func() time.Time
+	recorded  map[dedupKey]time.Time
+	lastPrune time.Time
+}
+
+// NewDedup returns a Recorder that records events using the supplied inner
+// Recorder, suppressing any event that duplicates one recorded for the same
+// object (as identified by its UID) within the supplied window. Recorders
+// returned by WithAnnotations share deduplication state with their parent.
+func NewDedup(inner Recorder, window time.Duration) *DedupRecorder {
+	return &DedupRecorder{
+		inner:  inner,
+		window: window,
+		seen:   &seenEvents{now: time.Now, recorded: map[dedupKey]time.Time{}},
+	}
+}
+
+// Event records the supplied event unless it is a duplicate.
+func (r *DedupRecorder) Event(obj runtime.Object, e Event) {
+	m, ok := obj.(metav1.Object)
+	if !ok {
+		// We can't identify the object, so we can't deduplicate its events.
+		r.inner.Event(obj, e)
+		return
+	}
+
+	k := dedupKey{uid: m.GetUID(), typ: e.Type, reason: e.Reason, message: e.Message}
+	if r.seen.recentlyRecorded(k, r.window) {
+		return
+	}
+	r.inner.Event(obj, e)
+}
+
+// WithAnnotations returns a new *DedupRecorder that includes the supplied
+// annotations with all recorded events.
+func (r *DedupRecorder) WithAnnotations(keysAndValues ...string) Recorder {
+	return &DedupRecorder{
+		inner:  r.inner.WithAnnotations(keysAndValues...),
+		window: r.window,
+		seen:   r.seen,
+	}
+}
+
+// recentlyRecorded returns true if the supplied key was recorded within the
+// supplied window. If not, the key is recorded.
+func (s *seenEvents) recentlyRecorded(k dedupKey, window time.Duration) bool {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	now := s.now()
+
+	// Forget about any events that fell out of the window, but don't bother
+	// checking more than once per window.
+	if now.Sub(s.lastPrune) > window {
+		for sk, t := range s.recorded {
+			if now.Sub(t) > window {
+				delete(s.recorded, sk)
+			}
+		}
+		s.lastPrune = now
+	}
+
+	if t, ok := s.recorded[k]; ok && now.Sub(t) <= window {
+		return true
+	}
+	s.recorded[k] = now
+	return false
+}
@@ -19,8 +19,14 @@ package event
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
 )
 
 func TestSliceMap(t *testing.T) {
@@ -88,3 +94,124 @@ func TestSliceMap(t *testing.T) {
 	}
 
 }
+
+type recordedEvent struct {
+	UID         types.UID
+	Event       Event
+	Annotations []string
+}
+
+type capturingRecorder struct {
+	events      *[]recordedEvent
+	annotations []string
+}
+
+func (r *capturingRecorder) Event(obj runtime.Object, e Event) {
+	*r.events = append(*r.events, recordedEvent{UID: obj.(metav1.Object).GetUID(), Event: e, Annotations: r.annotations})
+}
+
+func (r *capturingRecorder) WithAnnotations(keysAndValues ...string) Recorder {
+	return &capturingRecorder{events: r.events, annotations: append(r.annotations, keysAndValues...)}
+}
+
+func TestDedupRecorder(t *testing.T) {
+	errBoom := errors.New("boom")
+	start := time.Now()
+
+	a := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{UID: "a"}}
+	b := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{UID: "b"}}
+
+	type record struct {
+		after time.Duration
+		obj   runtime.Object
+		e     Event
+	}
+
+	cases := map[string]struct {
+		reason  string
+		window  time.Duration
+		records []record
+		want    []recordedEvent
+	}{
+		"DuplicateWithinWindow": {
+			reason: "Identical events for the same object within the window should be suppressed.",
+			window: time.Minute,
+			records: []record{
+				{after: 0, obj: a, e: Warning("CannotCreate", errBoom)},
+				{after: 10 * time.Second, obj: a, e: Warning("CannotCreate", errBoom)},
+			},
+			want: []recordedEvent{
+				{UID: "a", Event: Warning("CannotCreate", errBoom)},
+			},
+		},
+		"DuplicateAfterWindow": {
+			reason: "Identical events for the same object outside the window should be recorded.",
+			window: time.Minute,
+			records: []record{
+				{after: 0, obj: a, e: Warning("CannotCreate", errBoom)},
+				{after: 2 * time.Minute, obj: a, e: Warning("CannotCreate", errBoom)},
+			},
+			want: []recordedEvent{
+				{UID: "a", Event: Warning("CannotCreate", errBoom)},
+				{UID: "a", Event: Warning("CannotCreate", errBoom)},
+			},
+		},
+		"DifferentObjects": {
+			reason: "Identical events for different objects should be recorded.",
+			window: time.Minute,
+			records: []record{
+				{after: 0, obj: a, e: Warning("CannotCreate", errBoom)},
+				{after: 0, obj: b, e: Warning("CannotCreate", errBoom)},
+			},
+			want: []recordedEvent{
+				{UID: "a", Event: Warning("CannotCreate", errBoom)},
+				{UID: "b", Event: Warning("CannotCreate", errBoom)},
+			},
+		},
+		"DifferentMessages": {
+			reason: "Events with different messages for the same object should be recorded.",
+			window: time.Minute,
+			records: []record{
+				{after: 0, obj: a, e: Normal("Created", "one")},
+				{after: 0, obj: a, e: Normal("Created", "two")},
+			},
+			want: []recordedEvent{
+				{UID: "a", Event: Normal("Created", "one")},
+				{UID: "a", Event: Normal("Created", "two")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := make([]recordedEvent, 0)
+			r := NewDedup(&capturingRecorder{events: &got}, tc.window)
+
+			for _, rec := range tc.records {
+				now := start.Add(rec.after)
+				r.seen.now = func() time.Time { return now }
+				r.Event(rec.obj, rec.e)
+			}
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("%s\nr.Event(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDedupRecorderWithAnnotations(t *testing.T) {
+	got := make([]recordedEvent, 0)
+	r := NewDedup(&capturingRecorder{events: &got}, time.Minute)
+	obj := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{UID: "a"}}
+
+	r.WithAnnotations("external-name", "one").Event(obj, Normal("Created", "cool"))
+	r.WithAnnotations("external-name", "two").Event(obj, Normal("Created", "cool"))
+
+	want := []recordedEvent{
+		{UID: "a", Event: Normal("Created", "cool"), Annotations: []string{"external-name", "one"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Recorders returned by WithAnnotations should share deduplication state\nr.Event(...): -want, +got:\n%s", diff)
+	}
+}
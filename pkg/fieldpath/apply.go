@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+// BuildApplyConfig returns a new object containing only the values of the
+// supplied object at the supplied field paths, along with their parents. It is
+// intended for use as the body of a server-side apply request that manages only
+// the supplied field paths. Wildcards in the supplied field paths are expanded
+// against the supplied object. Field paths that do not exist in the supplied
+// object are ignored.
+//
+// Values are copied, such that modifying the returned object does not modify
+// the supplied object. Array elements keep their index. The supplied field
+// paths must project every element of any array they index into; an error is
+// returned otherwise, because server-side apply would treat an unprojected
+// element as null, or as removed. Note that server-side apply replaces atomic
+// lists in their entirety, so field paths that project only some fields of the
+// elements of an atomic list, e.g. spec.items[*].name, will remove the other
+// fields. Project the entire list, e.g. spec.items, instead.
+func BuildApplyConfig(src map[string]any, paths []string) (map[string]any, error) {
+	from := Pave(src)
+	to := Pave(make(map[string]any))
+
+	for _, path := range paths {
+		expanded, err := from.ExpandWildcards(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot expand field path %q", path)
+		}
+		for _, p := range expanded {
+			var v any
+			if err := from.GetValueInto(p, &v); err != nil {
+				if IsNotFound(err) {
+					continue
+				}
+				return nil, errors.Wrapf(err, "cannot get value at field path %q", p)
+			}
+			if err := to.SetValue(p, v); err != nil {
+				return nil, errors.Wrapf(err, "cannot set value at field path %q", p)
+			}
+		}
+	}
+
+	if sg, ok := unprojected(to.UnstructuredContent(), src, nil); ok {
+		return nil, errors.Errorf("%s: not projected; field paths must project every element of an array", sg)
+	}
+
+	return to.UnstructuredContent(), nil
+}
+
+// unprojected returns the field path of the first element of an array in the
+// supplied projection that was not projected from the supplied source, if any.
+func unprojected(projection, src any, path Segments) (Segments, bool) {
+	switch p := projection.(type) {
+	case map[string]any:
+		s, _ := src.(map[string]any)
+		for k, v := range p {
+			if sg, ok := unprojected(v, s[k], append(path[:len(path):len(path)], Field(k))); ok {
+				return sg, true
+			}
+		}
+	case []any:
+		s, _ := src.([]any)
+		for i := range s {
+			sg := append(path[:len(path):len(path)], Segment{Type: SegmentIndex, Index: uint(i)})
+			if i >= len(p) || (p[i] == nil && s[i] != nil) {
+				return sg, true
+			}
+			if sg, ok := unprojected(p[i], s[i], sg); ok {
+				return sg, true
+			}
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/json"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestBuildApplyConfig(t *testing.T) {
+	_, errParse := Parse("spec[size")
+
+	type want struct {
+		obj string
+		err error
+	}
+	cases := map[string]struct {
+		reason string
+		src    string
+		paths  []string
+		want   want
+	}{
+		"Fields": {
+			reason: "Only the listed fields and their parents should be projected.",
+			src:    `{"metadata":{"name":"cool","labels":{"a":"b"}},"spec":{"size":1,"region":"us"}}`,
+			paths:  []string{"metadata.name", "spec.size"},
+			want: want{
+				obj: `{"metadata":{"name":"cool"},"spec":{"size":1}}`,
+			},
+		},
+		"Object": {
+			reason: "A listed object should be projected in its entirety.",
+			src:    `{"spec":{"forProvider":{"a":"b","c":{"d":"e"}},"other":true}}`,
+			paths:  []string{"spec.forProvider"},
+			want: want{
+				obj: `{"spec":{"forProvider":{"a":"b","c":{"d":"e"}}}}`,
+			},
+		},
+		"Wildcards": {
+			reason: "Wildcards should be expanded against the source object.",
+			src:    `{"spec":{"items":[{"name":"a","size":1},{"name":"b","size":2}]}}`,
+			paths:  []string{"spec.items[*].name"},
+			want: want{
+				obj: `{"spec":{"items":[{"name":"a"},{"name":"b"}]}}`,
+			},
+		},
+		"Array": {
+			reason: "A listed array should be projected in its entirety.",
+			src:    `{"spec":{"items":[{"name":"a","size":1},{"name":"b","size":2}],"other":true}}`,
+			paths:  []string{"spec.items"},
+			want: want{
+				obj: `{"spec":{"items":[{"name":"a","size":1},{"name":"b","size":2}]}}`,
+			},
+		},
+		"SparseArray": {
+			reason: "Field paths that leave an earlier array element unprojected should return an error.",
+			src:    `{"spec":{"items":[{"name":"a"},{"name":"b"}]}}`,
+			paths:  []string{"spec.items[1].name"},
+			want: want{
+				err: errors.Errorf("%s: not projected; field paths must project every element of an array", "spec.items[0]"),
+			},
+		},
+		"TruncatedArray": {
+			reason: "Field paths that leave a later array element unprojected should return an error.",
+			src:    `{"spec":{"items":[{"name":"a"},{"name":"b"}]}}`,
+			paths:  []string{"spec.items[0].name"},
+			want: want{
+				err: errors.Errorf("%s: not projected; field paths must project every element of an array", "spec.items[1]"),
+			},
+		},
+		"NotFound": {
+			reason: "Paths that do not exist in the source object should be ignored.",
+			src:    `{"spec":{"size":1}}`,
+			paths:  []string{"spec.size", "spec.region", "status.atProvider"},
+			want: want{
+				obj: `{"spec":{"size":1}}`,
+			},
+		},
+		"InvalidPath": {
+			reason: "An invalid field path should return an error.",
+			src:    `{"spec":{"size":1}}`,
+			paths:  []string{"spec[size"},
+			want: want{
+				err: errors.Wrapf(errors.Wrapf(errParse, "cannot parse path %q", "spec[size"), "cannot expand field path %q", "spec[size"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			src := make(map[string]any)
+			_ = json.Unmarshal([]byte(tc.src), &src)
+
+			got, err := BuildApplyConfig(src, tc.paths)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nBuildApplyConfig(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.err != nil {
+				return
+			}
+
+			want := make(map[string]any)
+			_ = json.Unmarshal([]byte(tc.want.obj), &want)
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("\n%s\nBuildApplyConfig(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -30,6 +30,16 @@ limitations under the License.
 // * spec.items[0][8]
 // * apiVersion
 // * [42]
+// * metadata.annotations[~=^example\.com/]
+//
+// A bracketed segment that begins with ~= is a regular expression that matches
+// the keys of an object. Such segments are treated like wildcards; they are
+// matched against the keys of an object when a path is expanded. The regular
+// expression uses RE2 syntax, and must be valid when the path is parsed. It
+// matches any key that contains a match, so use ^ and $ to anchor it. Regular
+// expression segments may only be used to expand or delete fields; getting or
+// setting a value at a path that contains one is an error. Consequently object
+// keys that begin with ~= cannot be addressed in a field path.
 //
 // Invalid examples:
 //
@@ -44,6 +54,7 @@ package fieldpath
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"unicode/utf8"
@@ -78,7 +89,7 @@ func (sg Segments) String() string {
 	for _, s := range sg {
 		switch s.Type {
 		case SegmentField:
-			if s.Field == wildcard || strings.ContainsRune(s.Field, period) {
+			if s.Field == wildcard || isRegex(s) || strings.ContainsRune(s.Field, period) {
 				b.WriteString(fmt.Sprintf("[%s]", s.Field))
 				continue
 			}
@@ -127,6 +138,11 @@ func Parse(path string) (Segments, error) {
 			segments = append(segments, Field(i.val))
 		case itemFieldOrIndex:
 			segments = append(segments, FieldOrIndex(i.val))
+		case itemRegex:
+			if _, err := regexp.Compile(strings.TrimPrefix(i.val, regexPrefix)); err != nil {
+				return nil, errors.Wrapf(err, "invalid regular expression at position %d", i.pos)
+			}
+			segments = append(segments, Segment{Type: SegmentField, Field: i.val})
 		case itemError:
 			return nil, errors.Errorf("%s at position %d", i.val, i.pos)
 		}
@@ -134,14 +150,43 @@ func Parse(path string) (Segments, error) {
 	return segments, nil
 }
 
+// Validate returns an error if the supplied path is not a valid field path.
+// It may be used to validate a field path without an object to apply it to.
+func Validate(path string) error {
+	if path == "" {
+		return errors.New("field path is empty")
+	}
+	_, err := Parse(path)
+	return errors.Wrapf(err, "invalid field path %q", path)
+}
+
 const (
 	period       = '.'
 	leftBracket  = '['
 	rightBracket = ']'
 
-	wildcard = "*"
+	wildcard    = "*"
+	regexPrefix = "~="
 )
 
+// isRegex returns true if the supplied segment is a regular expression that
+// matches object keys.
+func isRegex(s Segment) bool {
+	return s.Type == SegmentField && strings.HasPrefix(s.Field, regexPrefix) && len(s.Field) > len(regexPrefix)
+}
+
+// addressable returns true if the supplied segment can be represented in a
+// field path without being parsed as a wildcard or a regular expression.
+func addressable(s Segment) bool {
+	return s.Type != SegmentField || (s.Field != wildcard && !strings.HasPrefix(s.Field, regexPrefix))
+}
+
+// regex returns the regular expression represented by the supplied segment.
+// The segment must have been validated by Parse.
+func regex(s Segment) *regexp.Regexp {
+	return regexp.MustCompile(strings.TrimPrefix(s.Field, regexPrefix))
+}
+
 type itemType int
 
 const (
@@ -151,6 +196,7 @@ const (
 	itemRightBracket
 	itemField
 	itemFieldOrIndex
+	itemRegex
 	itemEOL
 )
 
@@ -260,6 +306,11 @@ func lexFieldOrIndex(l *lexer) stateFn {
 	// lexLeftBracket.
 	rbi := strings.IndexRune(l.input[l.pos:], rightBracket)
 
+	// A regular expression may contain brackets.
+	if strings.HasPrefix(l.input[l.pos:], regexPrefix) {
+		return lexRegex
+	}
+
 	// A right bracket may not immediately follow a left bracket.
 	if rbi == 0 {
 		return l.errorf(l.pos, "unexpected %q", rightBracket)
@@ -276,6 +327,33 @@ func lexFieldOrIndex(l *lexer) stateFn {
 	return lexRightBracket
 }
 
+// A regular expression ends at the first right bracket that is neither escaped
+// nor part of a character class.
+func lexRegex(l *lexer) stateFn {
+	start := l.pos + len(regexPrefix)
+	escaped, class := false, false
+	for i, r := range l.input[start:] {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == leftBracket:
+			class = true
+		case r == rightBracket && class:
+			class = false
+		case r == rightBracket:
+			if i == 0 {
+				return l.errorf(start, "empty regular expression")
+			}
+			l.pos = start + i
+			l.emit(itemRegex)
+			return lexRightBracket
+		}
+	}
+	return l.errorf(l.start-utf8.RuneLen(leftBracket), "unterminated %q", leftBracket)
+}
+
 func lexRightBracket(l *lexer) stateFn {
 	l.pos += utf8.RuneLen(rightBracket)
 	l.emit(itemRightBracket)
@@ -18,6 +18,7 @@ package fieldpath
 
 import (
 	"math"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -65,6 +66,14 @@ func TestSegments(t *testing.T) {
 			},
 			want: "spec.containers[*].name",
 		},
+		"Regex": {
+			s: Segments{
+				Field("metadata"),
+				Field("annotations"),
+				Segment{Type: SegmentField, Field: "~=^cool"},
+			},
+			want: "metadata.annotations[~=^cool]",
+		},
 	}
 
 	for name, tc := range cases {
@@ -293,6 +302,44 @@ func TestParse(t *testing.T) {
 				err: errors.New("unexpected ']' at position 5"),
 			},
 		},
+		"Regex": {
+			reason: "A bracketed field beginning with ~= should be interpreted as a regular expression segment",
+			path:   `metadata.annotations[~=^example\.com/]`,
+			want: want{
+				s: Segments{Field("metadata"), Field("annotations"), Segment{Type: SegmentField, Field: `~=^example\.com/`}},
+			},
+		},
+		"RegexWithBrackets": {
+			reason: "A regular expression segment may contain character classes and escaped brackets",
+			path:   `data[~=^[a-z\]]+$].value`,
+			want: want{
+				s: Segments{Field("data"), Segment{Type: SegmentField, Field: `~=^[a-z\]]+$`}, Field("value")},
+			},
+		},
+		"InvalidRegex": {
+			reason: "A regular expression segment must be a valid regular expression",
+			path:   "data[~=a(]",
+			want: want{
+				err: func() error {
+					_, err := regexp.Compile("a(")
+					return errors.Wrap(err, "invalid regular expression at position 5")
+				}(),
+			},
+		},
+		"EmptyRegex": {
+			reason: "A regular expression segment may not be empty",
+			path:   "data[~=]",
+			want: want{
+				err: errors.New("empty regular expression at position 7"),
+			},
+		},
+		"UnterminatedRegex": {
+			reason: "A regular expression segment must be closed",
+			path:   "data[~=[a]",
+			want: want{
+				err: errors.New("unterminated '[' at position 4"),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -306,3 +353,39 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		path   string
+		want   error
+	}{
+		"Valid": {
+			reason: "A well formed field path should be valid.",
+			path:   "metadata.annotations['crossplane.io/external-name']",
+		},
+		"Empty": {
+			reason: "An empty field path should be invalid.",
+			path:   "",
+			want:   errors.New("field path is empty"),
+		},
+		"UnbalancedBrackets": {
+			reason: "A field path with an unterminated bracket should be invalid.",
+			path:   "spec.containers[0",
+			want:   errors.Wrap(errors.New("unterminated '[' at position 15"), `invalid field path "spec.containers[0"`),
+		},
+		"EmptySegment": {
+			reason: "A field path with an empty segment should be invalid.",
+			path:   "metadata..name",
+			want:   errors.Wrap(errors.New("unexpected '.' at position 9"), `invalid field path "metadata..name"`),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := Validate(tc.path)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nValidate(%s): %s: -want error, +got error:\n%s", tc.path, tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/json"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+// A PatchOperationType is the type of an RFC 6902 JSON patch operation.
+type PatchOperationType string
+
+// JSON patch operation types produced by DiffAsJSONPatch.
+const (
+	PatchOperationAdd     PatchOperationType = "add"
+	PatchOperationRemove  PatchOperationType = "remove"
+	PatchOperationReplace PatchOperationType = "replace"
+)
+
+// A PatchOperation is a single RFC 6902 JSON patch operation.
+// https://datatracker.ietf.org/doc/html/rfc6902
+type PatchOperation struct {
+	// Op is the operation to perform.
+	Op PatchOperationType `json:"op"`
+
+	// Path is a JSON pointer (RFC 6901) to the value the operation applies
+	// to.
+	Path string `json:"path"`
+
+	// Value to add or replace. Unused by remove operations.
+	Value any `json:"value,omitempty"`
+}
+
+// MarshalJSON marshals the operation to JSON. The value is always included
+// for add and replace operations, even when it is null.
+func (o PatchOperation) MarshalJSON() ([]byte, error) {
+	if o.Op == PatchOperationRemove {
+		return json.Marshal(struct {
+			Op   PatchOperationType `json:"op"`
+			Path string             `json:"path"`
+		}{Op: o.Op, Path: o.Path})
+	}
+	return json.Marshal(struct {
+		Op    PatchOperationType `json:"op"`
+		Path  string             `json:"path"`
+		Value any                `json:"value"`
+	}{Op: o.Op, Path: o.Path, Value: o.Value})
+}
+
+// DiffAsJSONPatch returns the RFC 6902 JSON patch operations that transform
+// the supplied from object into the supplied to object. Both objects must
+// look like JSON data that was unmarshalled into a map[string]any, for
+// example the content of an unstructured.Unstructured.
+//
+// Operations are produced in a deterministic order such that applying them
+// sequentially to from yields to. Arrays are compared element by element;
+// elements are appended to or removed from the end of an array when its
+// length changes.
+func DiffAsJSONPatch(from, to map[string]any) ([]PatchOperation, error) {
+	if err := mustBeJSONValue(nil, from); err != nil {
+		return nil, errors.Wrap(err, "invalid from object")
+	}
+	if err := mustBeJSONValue(nil, to); err != nil {
+		return nil, errors.Wrap(err, "invalid to object")
+	}
+
+	ops := make([]PatchOperation, 0)
+	diffValue(&ops, nil, from, to)
+	return ops, nil
+}
+
+func diffValue(ops *[]PatchOperation, s Segments, from, to any) {
+	fm, fok := from.(map[string]any)
+	tm, tok := to.(map[string]any)
+	if fok && tok {
+		diffObject(ops, s, fm, tm)
+		return
+	}
+
+	fa, fok := from.([]any)
+	ta, tok := to.([]any)
+	if fok && tok {
+		diffArray(ops, s, fa, ta)
+		return
+	}
+
+	if reflect.DeepEqual(from, to) {
+		return
+	}
+	*ops = append(*ops, PatchOperation{Op: PatchOperationReplace, Path: toJSONPointer(s), Value: to})
+}
+
+func diffObject(ops *[]PatchOperation, s Segments, from, to map[string]any) {
+	for _, k := range sortedKeys(from) {
+		if _, ok := to[k]; !ok {
+			*ops = append(*ops, PatchOperation{Op: PatchOperationRemove, Path: toJSONPointer(append(s, Field(k)))})
+		}
+	}
+	for _, k := range sortedKeys(to) {
+		fv, ok := from[k]
+		if !ok {
+			*ops = append(*ops, PatchOperation{Op: PatchOperationAdd, Path: toJSONPointer(append(s, Field(k))), Value: to[k]})
+			continue
+		}
+		diffValue(ops, append(s, Field(k)), fv, to[k])
+	}
+}
+
+func diffArray(ops *[]PatchOperation, s Segments, from, to []any) {
+	common := len(from)
+	if len(to) < common {
+		common = len(to)
+	}
+	for i := 0; i < common; i++ {
+		diffValue(ops, append(s, index(i)), from[i], to[i])
+	}
+
+	// Remove surplus elements from the end of the array first, so that the
+	// index of each subsequent removal remains valid.
+	for i := len(from) - 1; i >= common; i-- {
+		*ops = append(*ops, PatchOperation{Op: PatchOperationRemove, Path: toJSONPointer(append(s, index(i)))})
+	}
+	for i := common; i < len(to); i++ {
+		*ops = append(*ops, PatchOperation{Op: PatchOperationAdd, Path: toJSONPointer(append(s, index(i))), Value: to[i]})
+	}
+}
+
+func index(i int) Segment {
+	return Segment{Type: SegmentIndex, Index: uint(i)}
+}
+
+// mustBeJSONValue returns an error if the supplied value (or any value nested
+// within it) is not of a type produced by unmarshalling JSON into an any.
+func mustBeJSONValue(s Segments, v any) error {
+	switch t := v.(type) {
+	case nil, bool, string, float64, int64, int:
+		return nil
+	case map[string]any:
+		for k, e := range t {
+			if err := mustBeJSONValue(append(s, Field(k)), e); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []any:
+		for i, e := range t {
+			if err := mustBeJSONValue(append(s, index(i)), e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return errors.Errorf("%s: unsupported value type %T", s, v)
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// toJSONPointer returns the RFC 6901 JSON pointer for the supplied segments.
+// https://datatracker.ietf.org/doc/html/rfc6901
+func toJSONPointer(s Segments) string {
+	var b strings.Builder
+	for _, seg := range s {
+		b.WriteRune('/')
+		switch seg.Type {
+		case SegmentField:
+			b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(seg.Field))
+		case SegmentIndex:
+			b.WriteString(strconv.FormatUint(uint64(seg.Index), 10))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/json"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestDiffAsJSONPatch(t *testing.T) {
+	type want struct {
+		ops []PatchOperation
+		err error
+	}
+	cases := map[string]struct {
+		reason string
+		from   []byte
+		to     []byte
+		want   want
+	}{
+		"Identical": {
+			reason: "Identical objects should produce no operations.",
+			from:   []byte(`{"metadata":{"name":"cool"},"spec":{"items":["a","b"]}}`),
+			to:     []byte(`{"metadata":{"name":"cool"},"spec":{"items":["a","b"]}}`),
+			want: want{
+				ops: []PatchOperation{},
+			},
+		},
+		"ObjectFields": {
+			reason: "Added, removed, and changed object fields should produce add, remove, and replace operations.",
+			from:   []byte(`{"spec":{"a":"old","b":true}}`),
+			to:     []byte(`{"spec":{"a":"new","c":null}}`),
+			want: want{
+				ops: []PatchOperation{
+					{Op: PatchOperationRemove, Path: "/spec/b"},
+					{Op: PatchOperationReplace, Path: "/spec/a", Value: "new"},
+					{Op: PatchOperationAdd, Path: "/spec/c", Value: nil},
+				},
+			},
+		},
+		"ArrayGrows": {
+			reason: "Elements appended to an array should produce add operations in ascending order.",
+			from:   []byte(`{"items":["a"]}`),
+			to:     []byte(`{"items":["a","b","c"]}`),
+			want: want{
+				ops: []PatchOperation{
+					{Op: PatchOperationAdd, Path: "/items/1", Value: "b"},
+					{Op: PatchOperationAdd, Path: "/items/2", Value: "c"},
+				},
+			},
+		},
+		"ArrayShrinks": {
+			reason: "Elements removed from an array should produce remove operations in descending order.",
+			from:   []byte(`{"items":["a","b","c"]}`),
+			to:     []byte(`{"items":["z"]}`),
+			want: want{
+				ops: []PatchOperation{
+					{Op: PatchOperationReplace, Path: "/items/0", Value: "z"},
+					{Op: PatchOperationRemove, Path: "/items/2"},
+					{Op: PatchOperationRemove, Path: "/items/1"},
+				},
+			},
+		},
+		"NestedArrayElement": {
+			reason: "Changes within an object in an array should produce operations with the array index in their path.",
+			from:   []byte(`{"spec":{"containers":[{"name":"cool","image":"v1"}]}}`),
+			to:     []byte(`{"spec":{"containers":[{"name":"cool","image":"v2"}]}}`),
+			want: want{
+				ops: []PatchOperation{
+					{Op: PatchOperationReplace, Path: "/spec/containers/0/image", Value: "v2"},
+				},
+			},
+		},
+		"TypeChange": {
+			reason: "A value that changes type should be replaced wholesale.",
+			from:   []byte(`{"spec":{"value":["a"]}}`),
+			to:     []byte(`{"spec":{"value":{"a":"b"}}}`),
+			want: want{
+				ops: []PatchOperation{
+					{Op: PatchOperationReplace, Path: "/spec/value", Value: map[string]any{"a": "b"}},
+				},
+			},
+		},
+		"EscapedPointer": {
+			reason: "Field names containing '~' or '/' should be escaped per RFC 6901.",
+			from:   []byte(`{"metadata":{"annotations":{}}}`),
+			to:     []byte(`{"metadata":{"annotations":{"crossplane.io/external~name":"cool"}}}`),
+			want: want{
+				ops: []PatchOperation{
+					{Op: PatchOperationAdd, Path: "/metadata/annotations/crossplane.io~1external~0name", Value: "cool"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			from := make(map[string]any)
+			_ = json.Unmarshal(tc.from, &from)
+			to := make(map[string]any)
+			_ = json.Unmarshal(tc.to, &to)
+
+			got, err := DiffAsJSONPatch(from, to)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nDiffAsJSONPatch(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.ops, got); diff != "" {
+				t.Errorf("\n%s\nDiffAsJSONPatch(...): -want, +got:\n%s", tc.reason, diff)
+			}
+
+			// Applying the patch to from should always yield to.
+			p, _ := json.Marshal(got)
+			patch, err := jsonpatch.DecodePatch(p)
+			if err != nil {
+				t.Fatalf("\n%s\njsonpatch.DecodePatch(...): %s", tc.reason, err)
+			}
+			patched, err := patch.Apply(tc.from)
+			if err != nil {
+				t.Fatalf("\n%s\npatch.Apply(...): %s", tc.reason, err)
+			}
+			if !jsonpatch.Equal(patched, tc.to) {
+				t.Errorf("\n%s\npatch.Apply(...): want %s, got %s", tc.reason, tc.to, patched)
+			}
+		})
+	}
+}
+
+func TestDiffAsJSONPatchUnsupportedValue(t *testing.T) {
+	from := map[string]any{}
+	to := map[string]any{"spec": map[string]any{"ch": make(chan int)}}
+
+	_, err := DiffAsJSONPatch(from, to)
+	want := errors.Wrap(errors.New("spec.ch: unsupported value type chan int"), "invalid to object")
+	if diff := cmp.Diff(want, err, test.EquateErrors()); diff != "" {
+		t.Errorf("DiffAsJSONPatch(...): -want error, +got error:\n%s", diff)
+	}
+}
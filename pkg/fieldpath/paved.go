@@ -17,14 +17,24 @@ limitations under the License.
 package fieldpath
 
 import (
-	"strconv"
+	"sort"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/json"
+	kjson "sigs.k8s.io/json"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 )
 
+// ErrNotFound may be used with errors.Is to determine whether a field path was
+// not found because a field did not exist within an object.
+var ErrNotFound = errors.New("no such field")
+
+// ErrIndexOutOfRange may be used with errors.Is to determine whether a field
+// path was not found because an index was out of bounds in an array.
+var ErrIndexOutOfRange = errors.New("no such element")
+
 type errNotFound struct {
 	error
 }
@@ -33,9 +43,26 @@ func (e errNotFound) IsNotFound() bool {
 	return true
 }
 
+func (e errNotFound) Is(target error) bool {
+	return target == ErrNotFound //nolint:errorlint // Sentinel comparison.
+}
+
+type errIndexOutOfRange struct {
+	error
+}
+
+func (e errIndexOutOfRange) IsNotFound() bool {
+	return true
+}
+
+func (e errIndexOutOfRange) Is(target error) bool {
+	return target == ErrIndexOutOfRange //nolint:errorlint // Sentinel comparison.
+}
+
 // IsNotFound returns true if the supplied error indicates a field path was not
 // found, for example because a field did not exist within an object or an
-// index was out of bounds in an array.
+// index was out of bounds in an array. Use errors.Is with ErrNotFound or
+// ErrIndexOutOfRange to distinguish these cases.
 func IsNotFound(err error) bool {
 	cause := errors.Cause(err)
 	_, ok := cause.(interface { //nolint: errorlint // Skip errorlint for interface type
@@ -56,6 +83,13 @@ func PaveObject(o runtime.Object) (*Paved, error) {
 	return Pave(u), errors.Wrap(err, "cannot convert object to unstructured data")
 }
 
+// ToObject writes the content of this Paved to the supplied runtime.Object,
+// which must be a non-nil pointer to an object. It is the inverse of
+// PaveObject.
+func (p *Paved) ToObject(o runtime.Object) error {
+	return errors.Wrap(runtime.DefaultUnstructuredConverter.FromUnstructured(p.UnstructuredContent(), o), "cannot convert unstructured data to object")
+}
+
 // Pave a JSON object, making it possible to get and set values by field path.
 func Pave(object map[string]any) *Paved {
 	return &Paved{object: object}
@@ -98,7 +132,7 @@ func getValueFromInterface(it any, s Segments) (any, error) {
 				return nil, errors.Errorf("%s: not an array", s[:i])
 			}
 			if int(current.Index) >= len(array) {
-				return nil, errNotFound{errors.Errorf("%s: no such element", s[:i+1])}
+				return nil, errIndexOutOfRange{errors.Errorf("%s: no such element", s[:i+1])}
 			}
 			if final {
 				return array[current.Index], nil
@@ -144,38 +178,104 @@ func (p *Paved) ExpandWildcards(path string) ([]string, error) {
 	}
 	paths := make([]string, len(segmentsArray))
 	for i, s := range segmentsArray {
+		for j := range s {
+			if !addressable(s[j]) {
+				return nil, errors.Errorf("%s: cannot represent key %q in a field path", s[:j], s[j].Field)
+			}
+		}
 		paths[i] = s.String()
 	}
 	return paths, nil
 }
 
+// Paths returns the field path of every leaf value of the object, in lexical
+// order. A leaf value is any value that is not an object or an array, or is an
+// empty object or array. Object keys that contain periods are bracketed. It
+// returns an error if the object contains a key that cannot be represented in
+// a field path, i.e. an empty key, one that contains brackets, a literal *, or
+// one that begins with ~=.
+//
+// Example:
+//
+// For a Paved object with the following data: []byte(`{"metadata":{"labels":{"a.b/c":"d"}},"spec":{"args":["start","now"]}}`),
+// Paths() returns:
+// []string{"metadata.labels[a.b/c]", "spec.args[0]", "spec.args[1]"},
+func (p *Paved) Paths() ([]string, error) {
+	paths := make([]string, 0)
+	if err := leafPaths(&paths, nil, p.object); err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func leafPaths(paths *[]string, s Segments, v any) error {
+	switch t := v.(type) {
+	case map[string]any:
+		if len(t) == 0 && len(s) > 0 {
+			break
+		}
+		for k, e := range t {
+			if k == "" || strings.ContainsAny(k, "[]") || !addressable(Segment{Type: SegmentField, Field: k}) {
+				return errors.Errorf("%s: cannot represent key %q in a field path", s, k)
+			}
+			if err := leafPaths(paths, append(s[:len(s):len(s)], Segment{Type: SegmentField, Field: k}), e); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []any:
+		if len(t) == 0 {
+			break
+		}
+		for i, e := range t {
+			if err := leafPaths(paths, append(s[:len(s):len(s)], index(i)), e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	*paths = append(*paths, s.String())
+	return nil
+}
+
+func expandWildcards(data any, segments Segments) ([]Segments, error) {
+	return expandWildcardsFrom(data, data, segments, 0)
+}
+
+// expandWildcardsFrom expands the wildcards and regular expressions of the
+// supplied segments, starting at the segment at index start. The supplied value
+// must be the value at segments[:start]. Segments before start are never
+// expanded, so a matched key that looks like a wildcard or a regular expression
+// is treated as a literal key.
+//
 // Note(turkenh): Explanation for nolint:gocyclo
 // Even complexity turns out to be high, it is mostly because we have duplicate
 // logic for arrays and maps and a couple of error handling.
-func expandWildcards(data any, segments Segments) ([]Segments, error) { //nolint:gocyclo
+func expandWildcardsFrom(data, it any, segments Segments, start int) ([]Segments, error) { //nolint:gocyclo
 	var res []Segments
-	it := data
-	for i, current := range segments {
+	for i := start; i < len(segments); i++ {
+		current := segments[i]
 		// wildcards are regular fields with "*" as string
 		if current.Type == SegmentField && current.Field == wildcard {
 			switch mapOrArray := it.(type) {
 			case []any:
-				for ix := range mapOrArray {
+				for ix, e := range mapOrArray {
 					expanded := make(Segments, len(segments))
 					copy(expanded, segments)
-					expanded = append(append(expanded[:i], FieldOrIndex(strconv.Itoa(ix))), expanded[i+1:]...)
-					r, err := expandWildcards(data, expanded)
+					expanded[i] = index(ix)
+					r, err := expandWildcardsFrom(data, e, expanded, i+1)
 					if err != nil {
 						return nil, errors.Wrapf(err, "%q: cannot expand wildcards", expanded)
 					}
 					res = append(res, r...)
 				}
 			case map[string]any:
-				for k := range mapOrArray {
+				for k, e := range mapOrArray {
 					expanded := make(Segments, len(segments))
 					copy(expanded, segments)
-					expanded = append(append(expanded[:i], Field(k)), expanded[i+1:]...)
-					r, err := expandWildcards(data, expanded)
+					expanded[i] = Segment{Type: SegmentField, Field: k}
+					r, err := expandWildcardsFrom(data, e, expanded, i+1)
 					if err != nil {
 						return nil, errors.Wrapf(err, "%q: cannot expand wildcards", expanded)
 					}
@@ -186,6 +286,31 @@ func expandWildcards(data any, segments Segments) ([]Segments, error) { //nolint
 			}
 			return res, nil
 		}
+		if isRegex(current) {
+			object, ok := it.(map[string]any)
+			if !ok {
+				return nil, errors.Errorf("%q: unexpected regular expression usage", segments[:i])
+			}
+			re := regex(current)
+			keys := make([]string, 0, len(object))
+			for k := range object {
+				if re.MatchString(k) {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				expanded := make(Segments, len(segments))
+				copy(expanded, segments)
+				expanded[i] = Segment{Type: SegmentField, Field: k}
+				r, err := expandWildcardsFrom(data, object[k], expanded, i+1)
+				if err != nil {
+					return nil, errors.Wrapf(err, "%q: cannot expand regular expression", expanded)
+				}
+				res = append(res, r...)
+			}
+			return res, nil
+		}
 		var err error
 		it, err = getValueFromInterface(data, segments[:i+1])
 		if IsNotFound(err) {
@@ -204,6 +329,9 @@ func (p *Paved) GetValue(path string) (any, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot parse path %q", path)
 	}
+	if hasRegex(segments) {
+		return nil, errors.Errorf("cannot get value of path %q: regular expressions are only supported when expanding or deleting fields", path)
+	}
 
 	return p.getValue(segments)
 }
@@ -221,6 +349,30 @@ func (p *Paved) GetValueInto(path string, out any) error {
 	return errors.Wrap(json.Unmarshal(js, out), "cannot unmarshal value from JSON")
 }
 
+// GetValueIntoStrict the supplied type. Unlike GetValueInto it returns an error
+// if the value contains fields that do not exist in the supplied type. Like
+// GetValueInto it decodes numbers into an interface{} as int64 when they are
+// integers, and as float64 otherwise.
+func (p *Paved) GetValueIntoStrict(path string, out any) error {
+	val, err := p.GetValue(path)
+	if err != nil {
+		return err
+	}
+	js, err := json.Marshal(val)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal value to JSON")
+	}
+	strict, err := kjson.UnmarshalStrict(js, out)
+	if err != nil {
+		return errors.Wrap(err, "cannot unmarshal value from JSON")
+	}
+	errs := errors.NewMultiError()
+	for _, err := range strict {
+		errs.Add(err)
+	}
+	return errors.Wrap(errs.ErrorOrNil(), "cannot unmarshal value from JSON")
+}
+
 // GetString value of the supplied field path.
 func (p *Paved) GetString(path string) (string, error) {
 	v, err := p.GetValue(path)
@@ -454,6 +606,9 @@ func (p *Paved) SetValue(path string, value any) error {
 	if err != nil {
 		return errors.Wrapf(err, "cannot parse path %q", path)
 	}
+	if hasRegex(segments) {
+		return errors.Errorf("cannot set value of path %q: regular expressions are only supported when expanding or deleting fields", path)
+	}
 	return p.setValue(segments, value)
 }
 
@@ -476,13 +631,37 @@ func (p *Paved) SetNumber(path string, value float64) error {
 // If the path points to an entry in an array, the element
 // on that index is removed and the next ones are pulled
 // back. If it is a field on a map, the field is
-// removed from the map.
+// removed from the map. If the path contains a regular
+// expression segment every matching field is deleted.
 func (p *Paved) DeleteField(path string) error {
 	segments, err := Parse(path)
 	if err != nil {
 		return errors.Wrapf(err, "cannot parse path %q", path)
 	}
-	return p.delete(segments)
+	if !hasRegex(segments) {
+		return p.delete(segments)
+	}
+	expanded, err := expandWildcards(p.object, segments)
+	if err != nil {
+		return errors.Wrapf(err, "cannot expand path %q", path)
+	}
+	// Delete in reverse order, so that deleting an array element does not
+	// change the index of elements we're yet to delete.
+	for i := len(expanded) - 1; i >= 0; i-- {
+		if err := p.delete(expanded[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func hasRegex(segments Segments) bool {
+	for _, s := range segments {
+		if isRegex(s) {
+			return true
+		}
+	}
+	return false
 }
 
 func (p *Paved) delete(segments Segments) error { // nolint:gocyclo
@@ -21,7 +21,9 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/json"
 
@@ -45,6 +47,11 @@ func TestIsNotFound(t *testing.T) {
 			err:    errors.Wrap(errNotFound{errors.New("boom")}, "because reasons"),
 			want:   true,
 		},
+		"IndexOutOfRange": {
+			reason: "An index out of range error should be considered a not found error.",
+			err:    errIndexOutOfRange{errors.New("boom")},
+			want:   true,
+		},
 		"SomethingElse": {
 			reason: "An error without method `IsNotFound() bool` should not be considered a not found error.",
 			err:    errors.New("boom"),
@@ -62,6 +69,40 @@ func TestIsNotFound(t *testing.T) {
 	}
 }
 
+func TestNotFoundErrorsIs(t *testing.T) {
+	p := Pave(map[string]any{"a": map[string]any{"b": []any{"x", "y"}}})
+
+	cases := map[string]struct {
+		reason          string
+		path            string
+		notFound        bool
+		indexOutOfRange bool
+	}{
+		"FieldMissing": {
+			reason:   "A missing field should be ErrNotFound.",
+			path:     "a.c[3].d",
+			notFound: true,
+		},
+		"IndexOutOfRange": {
+			reason:          "An index beyond the end of an array should be ErrIndexOutOfRange.",
+			path:            "a.b[3].d",
+			indexOutOfRange: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := p.GetValue(tc.path)
+			if got := errors.Is(err, ErrNotFound); got != tc.notFound {
+				t.Errorf("\n%s\nerrors.Is(p.GetValue(...), ErrNotFound): want %t, got %t", tc.reason, tc.notFound, got)
+			}
+			if got := errors.Is(err, ErrIndexOutOfRange); got != tc.indexOutOfRange {
+				t.Errorf("\n%s\nerrors.Is(p.GetValue(...), ErrIndexOutOfRange): want %t, got %t", tc.reason, tc.indexOutOfRange, got)
+			}
+		})
+	}
+}
+
 func TestGetValue(t *testing.T) {
 	type want struct {
 		value any
@@ -134,7 +175,7 @@ func TestGetValue(t *testing.T) {
 			path:   "spec.containers[1].name",
 			data:   []byte(`{"spec":{"containers":[{"name":"cool"}]}}`),
 			want: want{
-				err: errNotFound{errors.New("spec.containers[1]: no such element")},
+				err: errIndexOutOfRange{errors.New("spec.containers[1]: no such element")},
 			},
 		},
 		"NotAnArray": {
@@ -160,6 +201,14 @@ func TestGetValue(t *testing.T) {
 				err: errors.Wrap(errors.New("unexpected ']' at position 5"), "cannot parse path \"spec[]\""),
 			},
 		},
+		"Regex": {
+			reason: "Requesting a path that contains a regular expression should fail",
+			path:   "metadata.annotations[~=^example]",
+			data:   []byte(`{"metadata":{"annotations":{"~=^example":"junk"}}}`),
+			want: want{
+				err: errors.Errorf("cannot get value of path %q: regular expressions are only supported when expanding or deleting fields", "metadata.annotations[~=^example]"),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -255,6 +304,91 @@ func TestGetValueInto(t *testing.T) {
 	}
 }
 
+func TestGetValueIntoStrict(t *testing.T) {
+	type Struct struct {
+		Slice       []string `json:"slice"`
+		StringField string   `json:"string"`
+	}
+
+	type args struct {
+		path string
+		out  any
+	}
+	type want struct {
+		out any
+		err error
+	}
+	cases := map[string]struct {
+		reason string
+		data   []byte
+		args   args
+		want   want
+	}{
+		"Struct": {
+			reason: "It should be possible to get a value into a struct with matching fields.",
+			data:   []byte(`{"s":{"slice":["a"],"string":"b"}}`),
+			args: args{
+				path: "s",
+				out:  &Struct{},
+			},
+			want: want{
+				out: &Struct{Slice: []string{"a"}, StringField: "b"},
+			},
+		},
+		"UnknownField": {
+			reason: "Getting a value with a field that does not exist in the struct should return an error.",
+			data:   []byte(`{"s":{"slice":["a"],"strng":"b"}}`),
+			args: args{
+				path: "s",
+				out:  &Struct{},
+			},
+			want: want{
+				out: &Struct{Slice: []string{"a"}},
+				err: errors.Wrap(errors.New(`unknown field "strng"`), "cannot unmarshal value from JSON"),
+			},
+		},
+		"MissingPath": {
+			reason: "Getting a value from a fieldpath that doesn't exist should return an error.",
+			data:   []byte(`{}`),
+			args: args{
+				path: "s",
+				out:  &Struct{},
+			},
+			want: want{
+				out: &Struct{},
+				err: errNotFound{errors.New("s: no such field")},
+			},
+		},
+		"Numbers": {
+			reason: "Integers should be decoded into an interface{} as int64, and other numbers as float64.",
+			data:   []byte(`{"s":{"int":1,"float":1.5}}`),
+			args: args{
+				path: "s",
+				out:  &map[string]any{},
+			},
+			want: want{
+				out: &map[string]any{"int": int64(1), "float": float64(1.5)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in := make(map[string]any)
+			_ = json.Unmarshal(tc.data, &in)
+			p := Pave(in)
+
+			err := p.GetValueIntoStrict(tc.args.path, tc.args.out)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\np.GetValueIntoStrict(%s): %s: -want error, +got error:\n%s", tc.args.path, tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.out, tc.args.out); diff != "" {
+				t.Errorf("\np.GetValueIntoStrict(%s): %s: -want, +got:\n%s", tc.args.path, tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestGetString(t *testing.T) {
 	type want struct {
 		value string
@@ -811,6 +945,18 @@ func TestSetValue(t *testing.T) {
 				err:    errors.Wrap(errors.New("unexpected ']' at position 5"), "cannot parse path \"spec[]\""),
 			},
 		},
+		"Regex": {
+			reason: "Setting a path that contains a regular expression should fail",
+			data:   []byte(`{}`),
+			args: args{
+				path:  "metadata.annotations[~=^example]",
+				value: "y",
+			},
+			want: want{
+				object: map[string]any{},
+				err:    errors.Errorf("cannot set value of path %q: regular expressions are only supported when expanding or deleting fields", "metadata.annotations[~=^example]"),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -968,6 +1114,38 @@ func TestExpandWildcards(t *testing.T) {
 				err: errors.Wrap(errors.New("unexpected ']' at position 5"), "cannot parse path \"spec[]\""),
 			},
 		},
+		"Regex": {
+			reason: "It should expand a regular expression segment to every matching object key",
+			path:   `metadata.annotations[~=^example\.com/]`,
+			data:   []byte(`{"metadata":{"annotations":{"example.com/a":"a","example.com/b":"b","other.com/c":"c"}}}`),
+			want: want{
+				expanded: []string{"metadata.annotations[example.com/a]", "metadata.annotations[example.com/b]"},
+			},
+		},
+		"RegexInArray": {
+			reason: "It should return an error if a regular expression segment is used with an array",
+			path:   "items[~=^a]",
+			data:   []byte(`{"items":["a","b"]}`),
+			want: want{
+				err: errors.Wrapf(errors.New("\"items\": unexpected regular expression usage"), "cannot expand wildcards for segments: %q", "items[~=^a]"),
+			},
+		},
+		"RegexMatchesRegexLikeKey": {
+			reason: "It should return an error rather than recurse forever if a regular expression matches a key that looks like a regular expression",
+			path:   "a[~=x]",
+			data:   []byte(`{"a":{"~=x":1}}`),
+			want: want{
+				err: errors.Errorf("%s: cannot represent key %q in a field path", "a", "~=x"),
+			},
+		},
+		"WildcardMatchesWildcardKey": {
+			reason: "It should return an error rather than recurse forever if a wildcard matches a key that looks like a wildcard",
+			path:   "a[*]",
+			data:   []byte(`{"a":{"*":1}}`),
+			want: want{
+				err: errors.Errorf("%s: cannot represent key %q in a field path", "a", "*"),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -1256,6 +1434,32 @@ func TestDeleteField(t *testing.T) {
 				},
 			},
 		},
+		"Regex": {
+			reason: "It should delete every field matching a regular expression segment.",
+			data:   []byte(`{"metadata":{"annotations":{"example.com/a":"a","example.com/b":"b","other.com/c":"c"}}}`),
+			args: args{
+				path: `metadata.annotations[~=^example\.com/]`,
+			},
+			want: want{
+				object: map[string]any{
+					"metadata": map[string]any{
+						"annotations": map[string]any{"other.com/c": "c"},
+					},
+				},
+			},
+		},
+		"RegexMatchesRegexLikeKey": {
+			reason: "It should delete a matching key that looks like a regular expression without expanding it again.",
+			data:   []byte(`{"a":{"~=x":{"b":"c"},"y":"z"}}`),
+			args: args{
+				path: "a[~=x]",
+			},
+			want: want{
+				object: map[string]any{
+					"a": map[string]any{"y": "z"},
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -1274,3 +1478,145 @@ func TestDeleteField(t *testing.T) {
 		})
 	}
 }
+
+func TestToObject(t *testing.T) {
+	type want struct {
+		obj runtime.Object
+		err error
+	}
+	cases := map[string]struct {
+		reason string
+		data   []byte
+		obj    runtime.Object
+		want   want
+	}{
+		"Success": {
+			reason: "It should be possible to convert paved content to a typed object.",
+			data:   []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cool"},"data":{"cool":"very"}}`),
+			obj:    &corev1.ConfigMap{},
+			want: want{
+				obj: &corev1.ConfigMap{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+					ObjectMeta: metav1.ObjectMeta{Name: "cool"},
+					Data:       map[string]string{"cool": "very"},
+				},
+			},
+		},
+		"InvalidContent": {
+			reason: "Paved content that does not match the object's schema should return an error.",
+			data:   []byte(`{"data":"cool"}`),
+			obj:    &corev1.ConfigMap{},
+			want: want{
+				obj: &corev1.ConfigMap{},
+				err: cmpopts.AnyError,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in := make(map[string]any)
+			_ = json.Unmarshal(tc.data, &in)
+			p := Pave(in)
+
+			err := p.ToObject(tc.obj)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Fatalf("\np.ToObject(...): %s: -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obj, tc.obj); diff != "" {
+				t.Errorf("\np.ToObject(...): %s: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPaths(t *testing.T) {
+	type want struct {
+		paths []string
+		err   error
+	}
+	cases := map[string]struct {
+		reason string
+		data   []byte
+		want   want
+	}{
+		"Empty": {
+			reason: "An empty object should have no paths.",
+			data:   []byte(`{}`),
+			want: want{
+				paths: []string{},
+			},
+		},
+		"NestedObjectsAndArrays": {
+			reason: "Every leaf of nested objects and arrays should be returned in lexical order.",
+			data:   []byte(`{"spec":{"containers":[{"name":"cool","args":["start","now"]}],"replicas":3},"apiVersion":"v1"}`),
+			want: want{
+				paths: []string{
+					"apiVersion",
+					"spec.containers[0].args[0]",
+					"spec.containers[0].args[1]",
+					"spec.containers[0].name",
+					"spec.replicas",
+				},
+			},
+		},
+		"EmptyLeaves": {
+			reason: "Empty objects, empty arrays, and nulls should be returned as leaves.",
+			data:   []byte(`{"spec":{"a":{},"b":[],"c":null}}`),
+			want: want{
+				paths: []string{"spec.a", "spec.b", "spec.c"},
+			},
+		},
+		"KeysWithPeriods": {
+			reason: "Object keys containing periods should be bracketed.",
+			data:   []byte(`{"metadata":{"annotations":{"crossplane.io/external-name":"cool"}},"data":{".config.yml":"a"}}`),
+			want: want{
+				paths: []string{"data[.config.yml]", "metadata.annotations[crossplane.io/external-name]"},
+			},
+		},
+		"KeyWithBrackets": {
+			reason: "Object keys containing brackets cannot be represented in a field path.",
+			data:   []byte(`{"data":{"a[0]":"b"}}`),
+			want: want{
+				err: errors.Errorf("%s: cannot represent key %q in a field path", "data", "a[0]"),
+			},
+		},
+		"WildcardKey": {
+			reason: "Object keys that are a literal * cannot be represented in a field path, because they would be parsed as a wildcard.",
+			data:   []byte(`{"data":{"*":"b"}}`),
+			want: want{
+				err: errors.Errorf("%s: cannot represent key %q in a field path", "data", "*"),
+			},
+		},
+		"RegexKey": {
+			reason: "Object keys beginning with ~= cannot be represented in a field path, because they would be parsed as a regular expression.",
+			data:   []byte(`{"data":{"~=b":"c"}}`),
+			want: want{
+				err: errors.Errorf("%s: cannot represent key %q in a field path", "data", "~=b"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in := make(map[string]any)
+			_ = json.Unmarshal(tc.data, &in)
+			p := Pave(in)
+
+			got, err := p.Paths()
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\np.Paths(): %s: -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.paths, got); diff != "" {
+				t.Errorf("\np.Paths(): %s: -want, +got:\n%s", tc.reason, diff)
+			}
+
+			// Every path should be readable.
+			for _, path := range got {
+				if _, err := p.GetValue(path); err != nil {
+					t.Errorf("\np.Paths(): %s: p.GetValue(%q): %s", tc.reason, path, err)
+				}
+			}
+		})
+	}
+}
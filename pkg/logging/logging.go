@@ -72,23 +72,110 @@ func (l nopLogger) WithValues(keysAndValues ...any) Logger { return nopLogger{}
 
 // NewLogrLogger returns a Logger that is satisfied by the supplied logr.Logger,
 // which may be satisfied in turn by various logging implementations (Zap, klog,
-// etc). Debug messages are logged at V(1).
+// etc). Debug messages are logged at V(1). Keys supplied to WithValues are
+// deduplicated, keeping the most recently supplied value.
 func NewLogrLogger(l logr.Logger) Logger {
-	return logrLogger{log: l}
+	return logrLogger{base: l, log: l}
 }
 
 type logrLogger struct {
+	// base is the logr.Logger we were created with, without any of the keys
+	// and values supplied to WithValues.
+	base logr.Logger
+
+	// log is base with kv supplied to its WithValues method.
 	log logr.Logger
+	kv  []any
 }
 
 func (l logrLogger) Info(msg string, keysAndValues ...any) {
-	l.log.Info(msg, keysAndValues...)
+	if !hasDuplicateKeys(l.kv, keysAndValues) {
+		l.log.Info(msg, keysAndValues...)
+		return
+	}
+	l.base.Info(msg, mergeKeysAndValues(l.kv, keysAndValues)...)
 }
 
 func (l logrLogger) Debug(msg string, keysAndValues ...any) {
-	l.log.V(1).Info(msg, keysAndValues...)
+	if !hasDuplicateKeys(l.kv, keysAndValues) {
+		l.log.V(1).Info(msg, keysAndValues...)
+		return
+	}
+	l.base.V(1).Info(msg, mergeKeysAndValues(l.kv, keysAndValues)...)
 }
 
 func (l logrLogger) WithValues(keysAndValues ...any) Logger {
-	return logrLogger{log: l.log.WithValues(keysAndValues...)}
+	if len(l.kv)%2 == 0 && !hasDuplicateKeys(l.kv, keysAndValues) {
+		kv := make([]any, 0, len(l.kv)+len(keysAndValues))
+		kv = append(append(kv, l.kv...), keysAndValues...)
+		return logrLogger{base: l.base, log: l.log.WithValues(keysAndValues...), kv: kv}
+	}
+	kv := mergeKeysAndValues(l.kv, keysAndValues)
+	return logrLogger{base: l.base, log: l.base.WithValues(kv...), kv: kv}
+}
+
+// hasDuplicateKeys returns true if any string key in b appears earlier in b,
+// or in a.
+func hasDuplicateKeys(a, b []any) bool {
+	for i := 0; i < len(b); i += 2 {
+		if indexOfKey(a, b[i]) >= 0 || indexOfKey(b[:i], b[i]) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeKeysAndValues returns a new array of alternating keys and values that
+// contains each key in a and b once. Keys keep the position at which they first
+// appear, and the value that appears last. Keys that are not strings are never
+// considered to be duplicates.
+func mergeKeysAndValues(a, b []any) []any {
+	if len(b) == 0 {
+		return a
+	}
+	out := make([]any, 0, len(a)+len(b)+1)
+	for i := 0; i+1 < len(a); i += 2 {
+		out = setKeyAndValue(out, a[i], a[i+1])
+	}
+	if len(a)%2 == 1 {
+		// A key without a value. We give it one so that it doesn't pair with
+		// the first key of b.
+		out = setKeyAndValue(out, a[len(a)-1], nil)
+	}
+	for i := 0; i+1 < len(b); i += 2 {
+		out = setKeyAndValue(out, b[i], b[i+1])
+	}
+	if len(b)%2 == 1 {
+		// A key without a value. We let the underlying logger decide how to
+		// handle it.
+		out = append(out, b[len(b)-1])
+	}
+	return out
+}
+
+// setKeyAndValue sets the value of the supplied key in the supplied array of
+// alternating keys and values, appending the key if it is not already present.
+func setKeyAndValue(kv []any, k, v any) []any {
+	if i := indexOfKey(kv, k); i >= 0 {
+		kv[i+1] = v
+		return kv
+	}
+	return append(kv, k, v)
+}
+
+// indexOfKey returns the index of the supplied key in the supplied array of
+// alternating keys and values, or -1 if it is not present. Keys that are not
+// strings are never found. We search linearly rather than building an index,
+// since loggers rarely have more than a handful of keys.
+func indexOfKey(kv []any, k any) int {
+	ks, ok := k.(string)
+	if !ok {
+		return -1
+	}
+	for i := 0; i < len(kv); i += 2 {
+		if s, ok := kv[i].(string); ok && s == ks {
+			return i
+		}
+	}
+	return -1
 }
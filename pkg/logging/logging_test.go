@@ -0,0 +1,148 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMergeKeysAndValues(t *testing.T) {
+	type args struct {
+		a []any
+		b []any
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   []any
+	}{
+		"NoNewKeysAndValues": {
+			reason: "The existing keys and values should be returned if there are no new ones.",
+			args: args{
+				a: []any{"a", 1},
+			},
+			want: []any{"a", 1},
+		},
+		"NewKeys": {
+			reason: "New keys should be appended.",
+			args: args{
+				a: []any{"a", 1},
+				b: []any{"b", 2},
+			},
+			want: []any{"a", 1, "b", 2},
+		},
+		"DuplicateKeys": {
+			reason: "Duplicate keys should keep their first position and their last value.",
+			args: args{
+				a: []any{"a", 1, "b", 2},
+				b: []any{"a", 3, "c", 4, "c", 5},
+			},
+			want: []any{"a", 3, "b", 2, "c", 5},
+		},
+		"NonStringKeys": {
+			reason: "Keys that are not strings should never be considered duplicates.",
+			args: args{
+				a: []any{1, "a"},
+				b: []any{1, "b"},
+			},
+			want: []any{1, "a", 1, "b"},
+		},
+		"OddNew": {
+			reason: "A new key without a value should be passed through.",
+			args: args{
+				a: []any{"a", 1},
+				b: []any{"b", 2, "c"},
+			},
+			want: []any{"a", 1, "b", 2, "c"},
+		},
+		"OddExisting": {
+			reason: "An existing key without a value should not be paired with the first new key.",
+			args: args{
+				a: []any{"a", 1, "b"},
+				b: []any{"c", 2},
+			},
+			want: []any{"a", 1, "b", nil, "c", 2},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := mergeKeysAndValues(tc.args.a, tc.args.b)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nmergeKeysAndValues(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLogrLogger(t *testing.T) {
+	type args struct {
+		with []any
+		info []any
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   string
+	}{
+		"NoDuplicates": {
+			reason: "Keys and values should be logged in the order they were supplied.",
+			args: args{
+				with: []any{"a", 1},
+				info: []any{"b", 2},
+			},
+			want: `"level"=0 "msg"="cool" "a"=1 "b"=2`,
+		},
+		"DuplicateWithValues": {
+			reason: "Keys supplied to WithValues more than once should be logged once, with the most recent value.",
+			args: args{
+				with: []any{"a", 1, "a", 2},
+			},
+			want: `"level"=0 "msg"="cool" "a"=2`,
+		},
+		"DuplicateInfo": {
+			reason: "Keys supplied to WithValues and Info should be logged once, with the value supplied to Info.",
+			args: args{
+				with: []any{"a", 1, "b", 2},
+				info: []any{"a", 3},
+			},
+			want: `"level"=0 "msg"="cool" "a"=3 "b"=2`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got string
+			l := NewLogrLogger(funcr.New(func(_, args string) { got = args }, funcr.Options{}))
+
+			// Supply each key and value pair via its own call to WithValues.
+			for i := 0; i+1 < len(tc.args.with); i += 2 {
+				l = l.WithValues(tc.args.with[i], tc.args.with[i+1])
+			}
+			l.Info("cool", tc.args.info...)
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nInfo(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
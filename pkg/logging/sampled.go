@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultSampleWindow is the period over which a sampled Logger counts
+// repeated messages.
+const DefaultSampleWindow = 1 * time.Minute
+
+// A SampledOption configures a sampled Logger.
+type SampledOption func(*samples)
+
+// WithSampleClock configures the function a sampled Logger uses to determine
+// the current time, and thus when each DefaultSampleWindow begins. It uses
+// time.Now by default.
+func WithSampleClock(now func() time.Time) SampledOption {
+	return func(s *samples) {
+		s.now = now
+	}
+}
+
+// NewSampled returns a Logger that samples the Debug messages it passes to the
+// supplied Logger. Within each DefaultSampleWindow the first occurrence of a
+// Debug message, and every nth occurrence thereafter, is logged. Repeated
+// messages are identified by their message string, regardless of structured
+// data. Info messages are always logged. All messages are logged if n is less
+// than two.
+func NewSampled(inner Logger, n int, o ...SampledOption) Logger {
+	s := &samples{
+		n:      n,
+		window: DefaultSampleWindow,
+		now:    time.Now,
+		counts: make(map[string]int),
+	}
+	for _, fn := range o {
+		fn(s)
+	}
+	return sampledLogger{log: inner, s: s}
+}
+
+type sampledLogger struct {
+	log Logger
+	s   *samples
+}
+
+func (l sampledLogger) Info(msg string, keysAndValues ...any) {
+	l.log.Info(msg, keysAndValues...)
+}
+
+func (l sampledLogger) Debug(msg string, keysAndValues ...any) {
+	if !l.s.sample(msg) {
+		return
+	}
+	l.log.Debug(msg, keysAndValues...)
+}
+
+// WithValues returns a Logger that shares samples with this Logger, so that a
+// message is sampled the same way regardless of the structured data included
+// with it.
+func (l sampledLogger) WithValues(keysAndValues ...any) Logger {
+	return sampledLogger{log: l.log.WithValues(keysAndValues...), s: l.s}
+}
+
+type samples struct {
+	mx     sync.Mutex
+	n      int
+	window time.Duration
+	now    func() time.Time
+	start  time.Time
+	counts map[string]int
+}
+
+// sample returns true if the supplied message should be logged.
+func (s *samples) sample(msg string) bool {
+	if s.n < 2 {
+		return true
+	}
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if now := s.now(); now.Sub(s.start) >= s.window {
+		s.start = now
+		s.counts = make(map[string]int)
+	}
+	c := s.counts[msg]
+	s.counts[msg] = c + 1
+	return c%s.n == 0
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type recordingLogger struct {
+	msgs *[]string
+}
+
+func (l recordingLogger) Info(msg string, _ ...any)  { *l.msgs = append(*l.msgs, "info: "+msg) }
+func (l recordingLogger) Debug(msg string, _ ...any) { *l.msgs = append(*l.msgs, "debug: "+msg) }
+func (l recordingLogger) WithValues(_ ...any) Logger { return l }
+
+func TestSampledLogger(t *testing.T) {
+	start := time.Now()
+
+	// A log call made at the supplied offset from start.
+	type call struct {
+		at    time.Duration
+		info  bool
+		msg   string
+		extra []any
+	}
+
+	cases := map[string]struct {
+		reason string
+		n      int
+		calls  []call
+		want   []string
+	}{
+		"OneInN": {
+			reason: "The first occurrence of a Debug message and every nth thereafter should be logged.",
+			n:      3,
+			calls: []call{
+				{msg: "a"}, {msg: "a"}, {msg: "a"}, {msg: "a"}, {msg: "a"}, {msg: "a"}, {msg: "a"},
+			},
+			want: []string{"debug: a", "debug: a", "debug: a"},
+		},
+		"MessagesSampledIndependently": {
+			reason: "Each Debug message should be sampled independently, regardless of its structured data.",
+			n:      2,
+			calls: []call{
+				{msg: "a", extra: []any{"k", 1}}, {msg: "b"}, {msg: "a", extra: []any{"k", 2}}, {msg: "b"}, {msg: "a"},
+			},
+			want: []string{"debug: a", "debug: b", "debug: a"},
+		},
+		"InfoNotSampled": {
+			reason: "Info messages should always be logged.",
+			n:      2,
+			calls: []call{
+				{msg: "a", info: true}, {msg: "a", info: true}, {msg: "a", info: true},
+			},
+			want: []string{"info: a", "info: a", "info: a"},
+		},
+		"SampleWindow": {
+			reason: "Counts should be reset when a new sample window begins.",
+			n:      3,
+			calls: []call{
+				{msg: "a"},
+				{msg: "a", at: DefaultSampleWindow - time.Second},
+				{msg: "a", at: DefaultSampleWindow},
+				{msg: "a", at: DefaultSampleWindow + time.Second},
+				{msg: "a", at: 2 * DefaultSampleWindow},
+			},
+			want: []string{"debug: a", "debug: a", "debug: a"},
+		},
+		"NotSampled": {
+			reason: "All messages should be logged if n is less than two.",
+			n:      1,
+			calls: []call{
+				{msg: "a"}, {msg: "a"}, {msg: "a"},
+			},
+			want: []string{"debug: a", "debug: a", "debug: a"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := []string{}
+			now := start
+			l := NewSampled(recordingLogger{msgs: &got}, tc.n, WithSampleClock(func() time.Time { return now }))
+
+			for _, c := range tc.calls {
+				now = start.Add(c.at)
+				if c.info {
+					l.Info(c.msg)
+					continue
+				}
+				l.WithValues(c.extra...).Debug(c.msg)
+			}
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nNewSampled(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
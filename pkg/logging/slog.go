@@ -0,0 +1,50 @@
+//go:build go1.21
+// +build go1.21
+
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"log/slog"
+)
+
+// NewSlogLogger returns a Logger that logs to the supplied slog.Handler.
+// Structured data is logged as attributes. Info messages are logged at
+// slog.LevelInfo and Debug messages at slog.LevelDebug. Keys supplied to
+// WithValues are deduplicated, keeping the most recently supplied value.
+// NewSlogLogger requires Go 1.21 or later, and is not built by earlier versions.
+func NewSlogLogger(h slog.Handler) Logger {
+	return slogLogger{log: slog.New(h)}
+}
+
+type slogLogger struct {
+	log *slog.Logger
+	kv  []any
+}
+
+func (l slogLogger) Info(msg string, keysAndValues ...any) {
+	l.log.Info(msg, mergeKeysAndValues(l.kv, keysAndValues)...)
+}
+
+func (l slogLogger) Debug(msg string, keysAndValues ...any) {
+	l.log.Debug(msg, mergeKeysAndValues(l.kv, keysAndValues)...)
+}
+
+func (l slogLogger) WithValues(keysAndValues ...any) Logger {
+	return slogLogger{log: l.log, kv: mergeKeysAndValues(l.kv, keysAndValues)}
+}
@@ -0,0 +1,74 @@
+//go:build go1.21
+// +build go1.21
+
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSlogLogger(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		log    func(l Logger)
+		want   string
+	}{
+		"Info": {
+			reason: "Info messages should be logged at slog.LevelInfo, with their structured data as attributes.",
+			log:    func(l Logger) { l.Info("cool", "a", 1) },
+			want:   `{"level":"INFO","msg":"cool","a":1}` + "\n",
+		},
+		"Debug": {
+			reason: "Debug messages should be logged at slog.LevelDebug.",
+			log:    func(l Logger) { l.Debug("cool") },
+			want:   `{"level":"DEBUG","msg":"cool"}` + "\n",
+		},
+		"WithValues": {
+			reason: "Keys supplied to WithValues should be logged once, with the most recently supplied value.",
+			log:    func(l Logger) { l.WithValues("a", 1, "b", 2).WithValues("a", 3).Info("cool", "b", 4) },
+			want:   `{"level":"INFO","msg":"cool","a":3,"b":4}` + "\n",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			h := slog.NewJSONHandler(b, &slog.HandlerOptions{
+				Level: slog.LevelDebug,
+				ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+					// Omit the time, which would make the output unpredictable.
+					if a.Key == slog.TimeKey {
+						return slog.Attr{}
+					}
+					return a
+				},
+			})
+
+			tc.log(NewSlogLogger(h))
+
+			if diff := cmp.Diff(tc.want, b.String()); diff != "" {
+				t.Errorf("\n%s\nNewSlogLogger(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -57,6 +57,18 @@ const (
 	// of a resource that indicates the last time creation of the external
 	// resource failed. Its value must be an RFC3999 timestamp.
 	AnnotationKeyExternalCreateFailed = "crossplane.io/external-create-failed"
+
+	// AnnotationKeyReconciliationPaused is the key in the annotations map
+	// of a resource that indicates that further reconciliations on the
+	// resource are paused. Reconciliation is paused when its value is
+	// "true".
+	AnnotationKeyReconciliationPaused = "crossplane.io/paused"
+
+	// AnnotationKeyReconcileTimeout is the key in the annotations map of a
+	// resource that overrides how long a single reconcile of the resource
+	// may take. Its value must be parseable by time.ParseDuration, e.g.
+	// "30s". Reconcilers may ignore it, or limit how long it may be.
+	AnnotationKeyReconcileTimeout = "crossplane.io/reconcile-timeout"
 )
 
 // Supported resources with all of these annotations will be fully or partially
@@ -174,15 +186,17 @@ func AddFinalizer(o metav1.Object, finalizer string) {
 	o.SetFinalizers(append(f, finalizer))
 }
 
-// RemoveFinalizer from the supplied Kubernetes object's metadata.
+// RemoveFinalizer from the supplied Kubernetes object's metadata. Every
+// occurrence of the finalizer is removed.
 func RemoveFinalizer(o metav1.Object, finalizer string) {
 	f := o.GetFinalizers()
-	for i, e := range f {
-		if e == finalizer {
-			f = append(f[:i], f[i+1:]...)
+	kept := f[:0]
+	for _, e := range f {
+		if e != finalizer {
+			kept = append(kept, e)
 		}
 	}
-	o.SetFinalizers(f)
+	o.SetFinalizers(kept)
 }
 
 // FinalizerExists checks whether given finalizer is already set.
@@ -246,6 +260,34 @@ func RemoveAnnotations(o metav1.Object, annotations ...string) {
 	o.SetAnnotations(a)
 }
 
+// CopyAnnotations with the supplied keys from one object to another. Keys
+// that are not set on the from object are ignored. Annotations of the to
+// object that are not named are left untouched.
+func CopyAnnotations(from, to metav1.Object, keys ...string) {
+	if a := selectKeys(from.GetAnnotations(), keys...); len(a) > 0 {
+		AddAnnotations(to, a)
+	}
+}
+
+// CopyLabels with the supplied keys from one object to another. Keys that are
+// not set on the from object are ignored. Labels of the to object that are not
+// named are left untouched.
+func CopyLabels(from, to metav1.Object, keys ...string) {
+	if l := selectKeys(from.GetLabels(), keys...); len(l) > 0 {
+		AddLabels(to, l)
+	}
+}
+
+func selectKeys(m map[string]string, keys ...string) map[string]string {
+	selected := make(map[string]string)
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			selected[k] = v
+		}
+	}
+	return selected
+}
+
 // WasDeleted returns true if the supplied object was deleted from the API server.
 func WasDeleted(o metav1.Object) bool {
 	return !o.GetDeletionTimestamp().IsZero()
@@ -412,3 +454,73 @@ func AllowsPropagationTo(from metav1.Object) map[types.NamespacedName]bool {
 
 	return to
 }
+
+// IsPaused returns true if the supplied object's reconciliation is paused via
+// the pause annotation.
+func IsPaused(o metav1.Object) bool {
+	return o.GetAnnotations()[AnnotationKeyReconciliationPaused] == "true"
+}
+
+// GetReconcileTimeout returns the reconcile timeout of the supplied object, as
+// set by the reconcile timeout annotation. It returns zero if the annotation is
+// not set, is not a valid duration, or is not positive.
+func GetReconcileTimeout(o metav1.Object) time.Duration {
+	d, err := time.ParseDuration(o.GetAnnotations()[AnnotationKeyReconcileTimeout])
+	if err != nil || d < 0 {
+		return 0
+	}
+	return d
+}
+
+// GenerateName returns a deterministic, DNS-safe name for the supplied object.
+// The name is derived from the supplied prefix and the object's namespace and
+// name, and is suffixed with a hash of the object's namespace, name, and UID.
+// The name is truncated to fit within maxLen characters if necessary; the
+// hash suffix is always preserved. A maxLen of zero or less means the name is
+// never truncated. Managed resources satisfy metav1.Object, so this function
+// may be used to generate external names for them.
+func GenerateName(o metav1.Object, prefix string, maxLen int) string {
+	// Writing to a hash never returns an error.
+	h := fnv.New32a()
+	h.Write([]byte(o.GetNamespace())) // nolint:errcheck
+	h.Write([]byte{'/'})              // nolint:errcheck
+	h.Write([]byte(o.GetName()))      // nolint:errcheck
+	h.Write([]byte{'/'})              // nolint:errcheck
+	h.Write([]byte(o.GetUID()))       // nolint:errcheck
+	suffix := fmt.Sprintf("%08x", h.Sum32())
+
+	base := dnsSafe(strings.Join([]string{prefix, o.GetNamespace(), o.GetName()}, "-"))
+	if maxLen <= 0 {
+		maxLen = len(base) + len(suffix) + 1
+	}
+	if maxLen <= len(suffix) {
+		return suffix[:maxLen]
+	}
+	if l := maxLen - len(suffix) - 1; len(base) > l {
+		base = strings.TrimRight(base[:l], "-")
+	}
+	if base == "" {
+		return suffix
+	}
+	return base + "-" + suffix
+}
+
+// dnsSafe returns the supplied string lowercased, with any runs of characters
+// that are not permitted in a DNS label replaced by a single hyphen, and with
+// leading and trailing hyphens trimmed.
+func dnsSafe(s string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			hyphen = false
+			continue
+		}
+		if !hyphen {
+			b.WriteRune('-')
+			hyphen = true
+		}
+	}
+	return strings.Trim(b.String(), "-")
+}
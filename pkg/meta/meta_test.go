@@ -537,6 +537,17 @@ func TestRemoveFinalizer(t *testing.T) {
 			},
 			want: []string{funalizer},
 		},
+		"DuplicateFinalizersExist": {
+			args: args{
+				o: &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Finalizers: []string{finalizer, finalizer, funalizer, finalizer},
+					},
+				},
+				finalizer: finalizer,
+			},
+			want: []string{funalizer},
+		},
 	}
 
 	for name, tc := range cases {
@@ -800,6 +811,120 @@ func TestRemoveAnnotations(t *testing.T) {
 	}
 }
 
+func TestCopyAnnotations(t *testing.T) {
+	keyA, valueA := "keyA", "valueA"
+	keyB, valueB := "keyB", "valueB"
+	keyC, valueC := "keyC", "valueC"
+
+	type args struct {
+		from metav1.Object
+		to   metav1.Object
+		keys []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   map[string]string
+	}{
+		"SelectedKeys": {
+			reason: "Only the named annotations should be copied.",
+			args: args{
+				from: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{keyA: valueA, keyB: valueB}}},
+				to:   &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{keyC: valueC}}},
+				keys: []string{keyA},
+			},
+			want: map[string]string{keyA: valueA, keyC: valueC},
+		},
+		"NilTarget": {
+			reason: "The target's annotations should be created if they are nil.",
+			args: args{
+				from: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{keyA: valueA}}},
+				to:   &corev1.Pod{},
+				keys: []string{keyA},
+			},
+			want: map[string]string{keyA: valueA},
+		},
+		"MissingKey": {
+			reason: "Named annotations that are not set on the source should be ignored.",
+			args: args{
+				from: &corev1.Pod{},
+				to:   &corev1.Pod{},
+				keys: []string{keyA},
+			},
+			want: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			CopyAnnotations(tc.args.from, tc.args.to, tc.args.keys...)
+
+			got := tc.args.to.GetAnnotations()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ntc.args.to.GetAnnotations(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCopyLabels(t *testing.T) {
+	keyA, valueA := "keyA", "valueA"
+	keyB, valueB := "keyB", "valueB"
+	keyC, valueC := "keyC", "valueC"
+
+	type args struct {
+		from metav1.Object
+		to   metav1.Object
+		keys []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   map[string]string
+	}{
+		"SelectedKeys": {
+			reason: "Only the named labels should be copied.",
+			args: args{
+				from: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{keyA: valueA, keyB: valueB}}},
+				to:   &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{keyC: valueC}}},
+				keys: []string{keyA},
+			},
+			want: map[string]string{keyA: valueA, keyC: valueC},
+		},
+		"NilTarget": {
+			reason: "The target's labels should be created if they are nil.",
+			args: args{
+				from: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{keyA: valueA}}},
+				to:   &corev1.Pod{},
+				keys: []string{keyA},
+			},
+			want: map[string]string{keyA: valueA},
+		},
+		"MissingKey": {
+			reason: "Named labels that are not set on the source should be ignored.",
+			args: args{
+				from: &corev1.Pod{},
+				to:   &corev1.Pod{},
+				keys: []string{keyA},
+			},
+			want: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			CopyLabels(tc.args.from, tc.args.to, tc.args.keys...)
+
+			got := tc.args.to.GetLabels()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ntc.args.to.GetLabels(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestWasDeleted(t *testing.T) {
 	now := metav1.Now()
 
@@ -1320,3 +1445,116 @@ func TestAllowsPropagationTo(t *testing.T) {
 		})
 	}
 }
+
+func TestIsPaused(t *testing.T) {
+	cases := map[string]struct {
+		o    metav1.Object
+		want bool
+	}{
+		"Paused": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyReconciliationPaused: "true"}}},
+			want: true,
+		},
+		"NotPaused": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyReconciliationPaused: "false"}}},
+			want: false,
+		},
+		"NoAnnotation": {
+			o:    &corev1.Pod{},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsPaused(tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsPaused(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetReconcileTimeout(t *testing.T) {
+	cases := map[string]struct {
+		o    metav1.Object
+		want time.Duration
+	}{
+		"ReconcileTimeoutExists": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyReconcileTimeout: "30s"}}},
+			want: 30 * time.Second,
+		},
+		"NoReconcileTimeout": {
+			o:    &corev1.Pod{},
+			want: 0,
+		},
+		"InvalidReconcileTimeout": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyReconcileTimeout: "soon"}}},
+			want: 0,
+		},
+		"NegativeReconcileTimeout": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyReconcileTimeout: "-30s"}}},
+			want: 0,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetReconcileTimeout(tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetReconcileTimeout(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateName(t *testing.T) {
+	o := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "Cool_NS", Name: "my.cool.Name", UID: "abc"}}
+
+	type args struct {
+		o      metav1.Object
+		prefix string
+		maxLen int
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   string
+	}{
+		"NoLimit": {
+			reason: "The name should be made DNS-safe and suffixed with a hash.",
+			args:   args{o: o, prefix: "xp", maxLen: 0},
+			want:   "xp-cool-ns-my-cool-name-17f8a1fe",
+		},
+		"WithinLimit": {
+			reason: "A name that fits within the limit should not be truncated.",
+			args:   args{o: o, prefix: "xp", maxLen: 63},
+			want:   "xp-cool-ns-my-cool-name-17f8a1fe",
+		},
+		"Truncated": {
+			reason: "A name that exceeds the limit should be truncated, preserving the hash suffix.",
+			args:   args{o: o, prefix: "xp", maxLen: 20},
+			want:   "xp-cool-ns-17f8a1fe",
+		},
+		"OnlyHash": {
+			reason: "Only the hash should be returned if there is no room for anything else.",
+			args:   args{o: o, prefix: "xp", maxLen: 9},
+			want:   "17f8a1fe",
+		},
+		"TruncatedHash": {
+			reason: "The hash should be truncated if the limit is shorter than it.",
+			args:   args{o: o, prefix: "xp", maxLen: 4},
+			want:   "17f8",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateName(tc.args.o, tc.args.prefix, tc.args.maxLen)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nGenerateName(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
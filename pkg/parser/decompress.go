@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// zstdMaxWindow is the largest zstd window a PackageParser will decode. It is
+// the window size RFC 8878 recommends decoders support. It bounds the memory
+// a small but malicious stream can make the decoder allocate.
+const zstdMaxWindow = 8 << 20
+
+// A decompressor decompresses content that begins with its magic number.
+type decompressor struct {
+	magic     []byte
+	newReader func(r io.Reader) (io.ReadCloser, error)
+}
+
+// decompressors supported by a PackageParser configured with decompression.
+// Supporting a new compression format requires only a new entry.
+var decompressors = []decompressor{
+	{
+		// https://datatracker.ietf.org/doc/html/rfc1952#page-5
+		magic: []byte{0x1f, 0x8b},
+		newReader: func(r io.Reader) (io.ReadCloser, error) {
+			return gzip.NewReader(r)
+		},
+	},
+	{
+		// https://datatracker.ietf.org/doc/html/rfc8878#section-3.1.1
+		magic: []byte{0x28, 0xb5, 0x2f, 0xfd},
+		newReader: func(r io.Reader) (io.ReadCloser, error) {
+			// By default the decoder starts a goroutine per CPU and accepts
+			// windows of up to 512MiB, which is far more than parsing a
+			// package needs and a lot to allocate for untrusted input.
+			d, err := zstd.NewReader(r,
+				zstd.WithDecoderConcurrency(1),
+				zstd.WithDecoderMaxWindow(zstdMaxWindow),
+				zstd.WithDecoderMaxMemory(zstdMaxWindow))
+			if err != nil {
+				return nil, err
+			}
+			return d.IOReadCloser(), nil
+		},
+	},
+}
+
+// decompress returns a reader of the decompressed content of the supplied
+// reader, if its content begins with the magic number of a supported
+// compression format. Otherwise it returns a reader of the unmodified content.
+// Closing the returned reader releases any resources held by its decompressor,
+// but does not close the supplied reader.
+func decompress(r io.Reader) (io.ReadCloser, error) {
+	maxLen := 0
+	for _, d := range decompressors {
+		if len(d.magic) > maxLen {
+			maxLen = len(d.magic)
+		}
+	}
+
+	br := bufio.NewReader(r)
+
+	// Peek returns the bytes that are available, along with an error, if the
+	// content is shorter than the longest magic number.
+	head, _ := br.Peek(maxLen)
+	for _, d := range decompressors {
+		if bytes.HasPrefix(head, d.magic) {
+			return d.newReader(br)
+		}
+	}
+	return io.NopCloser(br), nil
+}
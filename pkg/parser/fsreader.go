@@ -21,6 +21,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/spf13/afero"
 )
@@ -84,8 +85,8 @@ func SkipNotYAML() FilterFn {
 }
 
 // NewFsReadCloser returns an FsReadCloser that implements io.ReadCloser. It
-// walks the filesystem ahead of time, then reads file contents when Read is
-// invoked. It does not follow symbolic links.
+// walks the filesystem ahead of time, then reads file contents in lexical path
+// order when Read is invoked. It does not follow symbolic links.
 func NewFsReadCloser(fs afero.Fs, dir string, fns ...FilterFn) (*FsReadCloser, error) {
 	paths := []string{}
 	err := afero.Walk(fs, dir, func(path string, info os.FileInfo, err error) error {
@@ -104,6 +105,7 @@ func NewFsReadCloser(fs afero.Fs, dir string, fns ...FilterFn) (*FsReadCloser, e
 		paths = append(paths, path)
 		return nil
 	})
+	sort.Strings(paths)
 	return &FsReadCloser{
 		fs:         fs,
 		dir:        dir,
@@ -115,6 +117,24 @@ func NewFsReadCloser(fs afero.Fs, dir string, fns ...FilterFn) (*FsReadCloser, e
 	}, err
 }
 
+// readFirst causes the FsReadCloser to read the file at the supplied path
+// before any other file. It is a no-op if the FsReadCloser would not otherwise
+// read the file, or if reading has already started.
+func (r *FsReadCloser) readFirst(path string) {
+	if r.index != 0 || r.position != 0 {
+		return
+	}
+	path = filepath.Clean(path)
+	for i, p := range r.paths {
+		if filepath.Clean(p) != path {
+			continue
+		}
+		copy(r.paths[1:i+1], r.paths[:i])
+		r.paths[0] = p
+		return
+	}
+}
+
 func (r *FsReadCloser) Read(p []byte) (n int, err error) {
 	if r.wroteBreak {
 		r.index++
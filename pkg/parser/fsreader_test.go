@@ -0,0 +1,42 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+)
+
+func TestNewFsReadCloser(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	_ = afero.WriteFile(fs, "b.yaml", crdBytes, 0o644)
+	_ = afero.WriteFile(fs, "a/z.yaml", crdBytes, 0o644)
+	_ = afero.WriteFile(fs, "a-c.yaml", crdBytes, 0o644)
+	_ = afero.WriteFile(fs, "a.yaml", crdBytes, 0o644)
+
+	r, err := NewFsReadCloser(fs, ".", SkipDirs())
+	if err != nil {
+		t.Fatalf("NewFsReadCloser(...): unexpected error: %s", err)
+	}
+
+	want := []string{"a-c.yaml", "a.yaml", "a/z.yaml", "b.yaml"}
+	if diff := cmp.Diff(want, r.paths); diff != "" {
+		t.Errorf("NewFsReadCloser(...): paths should be in lexical order: -want, +got:\n%s", diff)
+	}
+}
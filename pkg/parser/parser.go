@@ -19,21 +19,52 @@ package parser
 import (
 	"bufio"
 	"context"
+	"fmt"
 	"io"
 	"io/ioutil"
+	"path/filepath"
 	"strings"
 	"unicode"
 
 	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer/json"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 )
 
+const (
+	errNilMetaScheme   = "meta scheme is nil"
+	errNilObjectScheme = "object scheme is nil"
+	errMutateMeta      = "cannot mutate meta object"
+	errMutateObject    = "cannot mutate object"
+	errDecompress      = "cannot decompress package"
+)
+
+// ErrMultipleMeta is returned by a PackageParser configured to expect a single
+// meta object when a package contains more than one.
+var ErrMultipleMeta = errors.New("package contains more than one meta object")
+
+// ErrDocumentTooDeep is returned by a PackageParser configured with a maximum
+// nesting depth when a package contains a document that is nested deeper.
+var ErrDocumentTooDeep = errors.New("document exceeds maximum nesting depth")
+
+// A DisallowedObjectKindError is returned by a PackageParser configured with
+// allowed object kinds when a package contains an object of another kind.
+type DisallowedObjectKindError struct {
+	GVK schema.GroupVersionKind
+}
+
+func (e *DisallowedObjectKindError) Error() string {
+	return fmt.Sprintf("object kind %s is not allowed", e.GVK)
+}
+
 // AnnotatedReadCloser is a wrapper around io.ReadCloser that allows
 // implementations to supply additional information about data that is read.
 type AnnotatedReadCloser interface {
@@ -47,10 +78,22 @@ type ObjectCreaterTyper interface {
 	runtime.ObjectTyper
 }
 
+// SchemeBackend returns an ObjectCreaterTyper backed by the supplied scheme. A
+// *runtime.Scheme can both create and determine the type of the objects that
+// are registered with it, so it may be passed to New directly. SchemeBackend
+// exists to make that explicit.
+func SchemeBackend(s *runtime.Scheme) ObjectCreaterTyper {
+	if s == nil {
+		return nil
+	}
+	return s
+}
+
 // Package is the set of metadata and objects in a package.
 type Package struct {
 	meta    []runtime.Object
 	objects []runtime.Object
+	raw     map[runtime.Object][]byte
 }
 
 // NewPackage creates a new Package.
@@ -58,6 +101,12 @@ func NewPackage() *Package {
 	return &Package{}
 }
 
+// NewPackageWith creates a new Package with the supplied metadata and
+// objects. It may be used to lint objects that were not produced by a Parser.
+func NewPackageWith(meta, objects []runtime.Object) *Package {
+	return &Package{meta: meta, objects: objects}
+}
+
 // GetMeta gets metadata from the package.
 func (p *Package) GetMeta() []runtime.Object {
 	return p.meta
@@ -68,6 +117,34 @@ func (p *Package) GetObjects() []runtime.Object {
 	return p.objects
 }
 
+// RawFor returns the raw bytes of the document the supplied object was decoded
+// from. It returns false if the object is not part of the package, or if the
+// package was parsed by a PackageParser that does not retain raw documents.
+func (p *Package) RawFor(o runtime.Object) ([]byte, bool) {
+	b, ok := p.raw[o]
+	return b, ok
+}
+
+// ExternalNames returns the external name of each managed resource in the
+// supplied package, keyed by namespace/name, or by name for cluster scoped
+// managed resources. The external name is empty for managed resources that do
+// not have one. Objects that are not managed resources are skipped.
+func ExternalNames(p *Package) map[string]string {
+	names := make(map[string]string)
+	for _, o := range p.GetObjects() {
+		mg, ok := o.(resource.Managed)
+		if !ok {
+			continue
+		}
+		key := mg.GetName()
+		if ns := mg.GetNamespace(); ns != "" {
+			key = ns + "/" + key
+		}
+		names[key] = meta.GetExternalName(mg)
+	}
+	return names
+}
+
 // Parser is a package parser.
 type Parser interface {
 	Parse(context.Context, io.ReadCloser) (*Package, error)
@@ -77,29 +154,200 @@ type Parser interface {
 type PackageParser struct {
 	metaScheme ObjectCreaterTyper
 	objScheme  ObjectCreaterTyper
+	objDecoder runtime.Decoder
+	objJSON    runtime.Decoder
+	singleMeta bool
+	allowed    map[schema.GroupVersionKind]bool
+	progress   func(docsParsed, bytesRead int)
+	maxDepth   int
+
+	collectErrors bool
+	metaOnly      bool
+	retainRaw     bool
+	decompress    bool
+
+	mutateMeta   func(o runtime.Object) error
+	mutateObject func(o runtime.Object) error
 }
 
-// New returns a new PackageParser.
-func New(meta, obj ObjectCreaterTyper) *PackageParser {
-	return &PackageParser{
+// A PackageParserOption configures a PackageParser.
+type PackageParserOption func(*PackageParser)
+
+// WithObjectDecoder overrides the decoder used to decode objects that are not
+// recognized by the meta scheme. This may be used to supply a decoder that
+// converts objects to a different API version as they are decoded. The meta
+// scheme is unaffected by this option. The supplied decoder must be able to
+// decode both YAML and JSON documents.
+func WithObjectDecoder(d runtime.Decoder) PackageParserOption {
+	return func(p *PackageParser) {
+		p.objDecoder = d
+		p.objJSON = d
+	}
+}
+
+// WithSingleMeta causes the PackageParser to return ErrMultipleMeta if a
+// package contains more than one object recognized by the meta scheme.
+func WithSingleMeta() PackageParserOption {
+	return func(p *PackageParser) {
+		p.singleMeta = true
+	}
+}
+
+// WithCollectErrors causes the PackageParser to continue parsing a package
+// after it fails to parse a document. The PackageParser returns all documents
+// it could parse, along with an errors.MultiError containing the (annotated)
+// error encountered for each document it could not. Errors reading a package
+// are always returned immediately.
+func WithCollectErrors() PackageParserOption {
+	return func(p *PackageParser) {
+		p.collectErrors = true
+	}
+}
+
+// WithMetaOnly causes the PackageParser to skip any document that is not
+// recognized by the meta scheme, rather than decoding it using the object
+// scheme. This is useful for callers that only need the metadata of a package,
+// which is considerably cheaper to parse than all of its objects.
+func WithMetaOnly() PackageParserOption {
+	return func(p *PackageParser) {
+		p.metaOnly = true
+	}
+}
+
+// WithDecompression causes the PackageParser to detect whether the content it
+// parses is compressed, and if so to decompress it. The compression format is
+// detected by its magic number. The gzip and zstd formats are supported.
+// Content that is not compressed is parsed as usual.
+func WithDecompression() PackageParserOption {
+	return func(p *PackageParser) {
+		p.decompress = true
+	}
+}
+
+// WithRawRetention causes the PackageParser to retain the raw bytes of the
+// document each meta object and object was decoded from. The raw bytes may be
+// retrieved using the parsed package's RawFor method. This is useful for
+// callers that need to compute a digest of each object's original source,
+// which may not survive re-serialization.
+func WithRawRetention() PackageParserOption {
+	return func(p *PackageParser) {
+		p.retainRaw = true
+	}
+}
+
+// WithObjectMutator causes the PackageParser to call the supplied function with
+// each object it decodes using the object scheme, before the object is added
+// to the package. The function may mutate the object, for example to add
+// labels. Parsing stops if the function returns an error.
+func WithObjectMutator(fn func(o runtime.Object) error) PackageParserOption {
+	return func(p *PackageParser) {
+		p.mutateObject = fn
+	}
+}
+
+// WithMetaMutator causes the PackageParser to call the supplied function with
+// each object it decodes using the meta scheme, before the object is added to
+// the package. The function may mutate the object. Parsing stops if the
+// function returns an error.
+func WithMetaMutator(fn func(o runtime.Object) error) PackageParserOption {
+	return func(p *PackageParser) {
+		p.mutateMeta = fn
+	}
+}
+
+// WithMaxDepth causes the PackageParser to return ErrDocumentTooDeep if a
+// package contains a document that is nested more than the supplied number of
+// levels deep. Nesting depth is determined by a quick structural scan of each
+// document before it is decoded, and is thus approximate. Documents of any
+// depth are allowed if the supplied depth is zero or less.
+func WithMaxDepth(n int) PackageParserOption {
+	return func(p *PackageParser) {
+		p.maxDepth = n
+	}
+}
+
+// WithProgress causes the PackageParser to call the supplied function each
+// time it parses a document, with the number of documents parsed and the
+// number of bytes read so far. The function is called synchronously, and thus
+// should return quickly.
+func WithProgress(fn func(docsParsed, bytesRead int)) PackageParserOption {
+	return func(p *PackageParser) {
+		p.progress = fn
+	}
+}
+
+// WithAllowedObjectKinds causes the PackageParser to return a
+// DisallowedObjectKindError if a package contains an object that is not
+// recognized by the meta scheme and is not one of the supplied kinds. All kinds
+// are allowed if no kinds are supplied.
+func WithAllowedObjectKinds(gvks ...schema.GroupVersionKind) PackageParserOption {
+	return func(p *PackageParser) {
+		if len(gvks) == 0 {
+			return
+		}
+		p.allowed = make(map[schema.GroupVersionKind]bool, len(gvks))
+		for _, gvk := range gvks {
+			p.allowed[gvk] = true
+		}
+	}
+}
+
+// New returns a new PackageParser. It returns an error if either the meta or
+// object scheme is nil. Use SchemeBackend to supply a *runtime.Scheme.
+func New(meta, obj ObjectCreaterTyper, o ...PackageParserOption) (*PackageParser, error) {
+	if isNil(meta) {
+		return nil, errors.New(errNilMetaScheme)
+	}
+	if isNil(obj) {
+		return nil, errors.New(errNilObjectScheme)
+	}
+	p := &PackageParser{
 		metaScheme: meta,
 		objScheme:  obj,
+		objDecoder: json.NewSerializerWithOptions(json.DefaultMetaFactory, obj, obj, json.SerializerOptions{Yaml: true}),
+		objJSON:    json.NewSerializerWithOptions(json.DefaultMetaFactory, obj, obj, json.SerializerOptions{}),
+	}
+	for _, po := range o {
+		po(p)
 	}
+	return p, nil
+}
+
+// isNil returns true if the supplied ObjectCreaterTyper is nil, or is a nil
+// *runtime.Scheme.
+func isNil(s ObjectCreaterTyper) bool {
+	if s == nil {
+		return true
+	}
+	rs, ok := s.(*runtime.Scheme)
+	return ok && rs == nil
 }
 
 // Parse is the underlying logic for parsing packages. It first attempts to
 // decode objects recognized by the meta scheme, then attempts to decode objects
 // recognized by the object scheme. Objects not recognized by either scheme
-// return an error rather than being skipped.
+// return an error rather than being skipped. Documents that are JSON objects
+// are decoded as JSON rather than YAML, because not all JSON is valid YAML.
 func (p *PackageParser) Parse(ctx context.Context, reader io.ReadCloser) (*Package, error) { //nolint:gocyclo
 	pkg := NewPackage()
 	if reader == nil {
 		return pkg, nil
 	}
 	defer func() { _ = reader.Close() }()
-	yr := yaml.NewYAMLReader(bufio.NewReader(reader))
-	dm := json.NewSerializerWithOptions(json.DefaultMetaFactory, p.metaScheme, p.metaScheme, json.SerializerOptions{Yaml: true})
-	do := json.NewSerializerWithOptions(json.DefaultMetaFactory, p.objScheme, p.objScheme, json.SerializerOptions{Yaml: true})
+	var in io.Reader = reader
+	if p.decompress {
+		d, err := decompress(reader)
+		if err != nil {
+			return pkg, annotateErr(errors.Wrap(err, errDecompress), reader)
+		}
+		defer func() { _ = d.Close() }()
+		in = d
+	}
+	yr := yaml.NewYAMLReader(bufio.NewReader(in))
+	ym := json.NewSerializerWithOptions(json.DefaultMetaFactory, p.metaScheme, p.metaScheme, json.SerializerOptions{Yaml: true})
+	jm := json.NewSerializerWithOptions(json.DefaultMetaFactory, p.metaScheme, p.metaScheme, json.SerializerOptions{})
+	docs, read, n := 0, 0, 0
+	errs := errors.NewMultiError()
 	for {
 		bytes, err := yr.Read()
 		if err != nil && !errors.Is(err, io.EOF) {
@@ -108,29 +356,88 @@ func (p *PackageParser) Parse(ctx context.Context, reader io.ReadCloser) (*Packa
 		if errors.Is(err, io.EOF) {
 			break
 		}
+		read += len(bytes)
 		if len(bytes) == 0 {
 			continue
 		}
 		if isWhiteSpace(bytes) {
 			continue
 		}
-		m, _, err := dm.Decode(bytes, nil, nil)
-		if err != nil {
-			// NOTE(hasheddan): we only try to decode with object scheme if the
-			// error is due the object not being registered in the meta scheme.
-			if !runtime.IsNotRegisteredError(err) {
-				return pkg, annotateErr(err, reader)
-			}
-			o, _, err := do.Decode(bytes, nil, nil)
-			if err != nil {
+		n++
+		if err := p.parseDocument(pkg, bytes, n, ym, jm); err != nil {
+			if !p.collectErrors {
 				return pkg, annotateErr(err, reader)
 			}
-			pkg.objects = append(pkg.objects, o)
+			errs.Add(annotateErr(err, reader))
 			continue
 		}
-		pkg.meta = append(pkg.meta, m)
+		docs++
+		if p.progress != nil {
+			p.progress(docs, read)
+		}
+	}
+	return pkg, errs.ErrorOrNil()
+}
+
+// parseDocument parses the nth document of a package, adding it to the
+// supplied package.
+func (p *PackageParser) parseDocument(pkg *Package, bytes []byte, n int, ym, jm runtime.Decoder) error {
+	if p.maxDepth > 0 && documentDepth(bytes) > p.maxDepth {
+		return errors.Wrapf(ErrDocumentTooDeep, "document %d", n)
+	}
+	dm, do := ym, p.objDecoder
+	if isJSONObject(bytes) {
+		dm, do = jm, p.objJSON
+	}
+	m, _, err := dm.Decode(bytes, nil, nil)
+	if err != nil {
+		// NOTE(hasheddan): we only try to decode with object scheme if the
+		// error is due the object not being registered in the meta scheme.
+		if !runtime.IsNotRegisteredError(err) {
+			return err
+		}
+		if p.metaOnly {
+			return nil
+		}
+		o, _, err := do.Decode(bytes, nil, nil)
+		if err != nil {
+			return err
+		}
+		if gvk := o.GetObjectKind().GroupVersionKind(); p.allowed != nil && !p.allowed[gvk] {
+			return &DisallowedObjectKindError{GVK: gvk}
+		}
+		if p.mutateObject != nil {
+			if err := p.mutateObject(o); err != nil {
+				return errors.Wrap(err, errMutateObject)
+			}
+		}
+		pkg.objects = append(pkg.objects, o)
+		p.retain(pkg, o, bytes)
+		return nil
+	}
+	if p.singleMeta && len(pkg.meta) > 0 {
+		return ErrMultipleMeta
+	}
+	if p.mutateMeta != nil {
+		if err := p.mutateMeta(m); err != nil {
+			return errors.Wrap(err, errMutateMeta)
+		}
+	}
+	pkg.meta = append(pkg.meta, m)
+	p.retain(pkg, m, bytes)
+	return nil
+}
+
+// retain the supplied raw bytes of the supplied object, if the PackageParser
+// is configured to retain raw documents.
+func (p *PackageParser) retain(pkg *Package, o runtime.Object, raw []byte) {
+	if !p.retainRaw {
+		return
+	}
+	if pkg.raw == nil {
+		pkg.raw = make(map[runtime.Object][]byte)
 	}
-	return pkg, nil
+	pkg.raw[o] = append([]byte(nil), raw...)
 }
 
 // isWhiteSpace determines whether the passed in bytes are all unicode white
@@ -146,10 +453,194 @@ func isWhiteSpace(bytes []byte) bool {
 	return empty
 }
 
-// annotateErr annotates an error if the reader is an AnnotatedReadCloser.
+// isJSONObject determines whether the passed in bytes appear to be a JSON
+// object, i.e. whether the first non white space character is an opening brace.
+func isJSONObject(bytes []byte) bool {
+	for _, b := range bytes {
+		if unicode.IsSpace(rune(b)) {
+			continue
+		}
+		return b == '{'
+	}
+	return false
+}
+
+// documentDepth returns the approximate nesting depth of the supplied YAML or
+// JSON document, determined by its indentation, sequence entry indicators and
+// flow collections. It does not decode the document.
+func documentDepth(doc []byte) int { //nolint:gocyclo
+	// This scan is intentionally simple; it is intended to reject
+	// pathologically nested documents before they are decoded, not to be an
+	// exact measure of depth.
+	max, flow, scalar := 0, 0, -1
+	indent, block, seq := 0, 0, 0
+	single, double := false, false
+	indents := make([]int, 0)
+	for _, line := range strings.Split(string(doc), "\n") {
+		content := strings.TrimLeft(line, " ")
+
+		// Lines within a multi-line quoted scalar are content, not structure.
+		// Anything following the end of the scalar continues the line on
+		// which it started.
+		continued := single || double
+		if continued {
+			i := closingQuote(content, double)
+			if i < 0 {
+				continue
+			}
+			single, double = false, false
+			content = content[i+1:]
+		}
+
+		if !continued {
+			if t := strings.TrimSpace(content); t == "" || strings.HasPrefix(t, "#") {
+				continue
+			}
+			// Lines of a block scalar are content, not structure.
+			if scalar >= 0 && len(line)-len(content) > scalar {
+				continue
+			}
+			indent = len(line) - len(content)
+
+			// Lines within a flow collection don't contribute to block depth.
+			startsFlow := flow > 0
+			if !startsFlow {
+				for len(indents) > 0 && indents[len(indents)-1] >= indent {
+					indents = indents[:len(indents)-1]
+				}
+				indents = append(indents, indent)
+			}
+
+			// Each sequence entry indicator after the first on a line starts
+			// a nested sequence.
+			seq = 0
+			for strings.HasPrefix(content, "- ") || content == "-" {
+				seq++
+				content = strings.TrimLeft(content[1:], " ")
+			}
+			if seq > 0 {
+				seq--
+			} else if !startsFlow && (strings.HasPrefix(content, "{") || strings.HasPrefix(content, "[")) {
+				// A flow collection that isn't within a block collection.
+				indents = indents[:len(indents)-1]
+			}
+			block = len(indents)
+
+			if d := block + seq; d > max {
+				max = d
+			}
+		}
+
+	scan:
+		for i := 0; i < len(content); i++ {
+			c := content[i]
+			switch {
+			case c == '"' && startsToken(content, i, flow > 0):
+				j := closingQuote(content[i+1:], true)
+				if j < 0 {
+					double = true
+					break scan
+				}
+				i += j + 1
+			case c == '\'' && startsToken(content, i, flow > 0):
+				j := closingQuote(content[i+1:], false)
+				if j < 0 {
+					single = true
+					break scan
+				}
+				i += j + 1
+			case c == '#' && i > 0 && content[i-1] == ' ':
+				break scan
+			case c == '{' || c == '[':
+				flow++
+				if d := block + seq + flow; d > max {
+					max = d
+				}
+			case c == '}' || c == ']':
+				if flow > 0 {
+					flow--
+				}
+			}
+		}
+
+		scalar = -1
+		if fields := strings.Fields(content); !single && !double && len(fields) > 0 && isBlockScalarIndicator(fields[len(fields)-1]) {
+			scalar = indent
+		}
+	}
+	return max
+}
+
+// startsToken returns true if the character at the supplied index of a line of
+// YAML starts a token, and thus may start a quoted scalar. A quote anywhere
+// else, e.g. the apostrophe in it's, is part of a plain scalar.
+func startsToken(line string, i int, inFlow bool) bool {
+	j := i - 1
+	for j >= 0 && line[j] == ' ' {
+		j--
+	}
+	if j < 0 {
+		return true
+	}
+	switch line[j] {
+	case '[', '{', ',':
+		return true
+	case ':':
+		// Within a flow collection a value may immediately follow the colon
+		// of a quoted key, e.g. {"a":"b"}.
+		return j < i-1 || inFlow
+	case '-', '?':
+		return j < i-1
+	}
+	return false
+}
+
+// closingQuote returns the index of the quote that closes a double or single
+// quoted scalar within the supplied string, which starts immediately after the
+// opening quote (or at the start of a subsequent line of the scalar). It
+// returns -1 if the scalar is not closed.
+func closingQuote(s string, double bool) int {
+	for i := 0; i < len(s); i++ {
+		switch {
+		case double && s[i] == '\\':
+			i++
+		case double && s[i] == '"':
+			return i
+		case !double && s[i] == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			// An escaped single quote.
+			i++
+		case !double && s[i] == '\'':
+			return i
+		}
+	}
+	return -1
+}
+
+// isBlockScalarIndicator returns true if the supplied token indicates the start
+// of a YAML block scalar, e.g. | or >-.
+func isBlockScalarIndicator(t string) bool {
+	if t == "" || (t[0] != '|' && t[0] != '>') {
+		return false
+	}
+	for _, c := range t[1:] {
+		if c != '-' && c != '+' && !unicode.IsDigit(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// annotateErr annotates an error if the reader is an AnnotatedReadCloser with a
+// non-nil annotation.
 func annotateErr(err error, reader io.ReadCloser) error {
-	if anno, ok := reader.(AnnotatedReadCloser); ok {
-		return errors.Wrapf(err, "%+v", anno.Annotate())
+	anno, ok := reader.(AnnotatedReadCloser)
+	if !ok {
+		return err
+	}
+	// Some AnnotatedReadClosers, like the MultiReadCloser, return a nil
+	// annotation when the underlying reader is not annotated.
+	if a := anno.Annotate(); a != nil {
+		return errors.Wrapf(err, "%+v", a)
 	}
 	return err
 }
@@ -243,6 +734,7 @@ type FsBackend struct {
 	fs    afero.Fs
 	dir   string
 	skips []FilterFn
+	meta  string
 }
 
 // NewFsBackend returns an FsBackend.
@@ -261,7 +753,14 @@ func (p *FsBackend) Init(ctx context.Context, bo ...BackendOption) (io.ReadClose
 	for _, o := range bo {
 		o(p)
 	}
-	return NewFsReadCloser(p.fs, p.dir, p.skips...)
+	r, err := NewFsReadCloser(p.fs, p.dir, p.skips...)
+	if err != nil {
+		return r, err
+	}
+	if p.meta != "" {
+		r.readFirst(filepath.Join(p.dir, p.meta))
+	}
+	return r, nil
 }
 
 // FsDir sets the directory of an FsBackend.
@@ -286,6 +785,20 @@ func FsFilters(skips ...FilterFn) BackendOption {
 	}
 }
 
+// MetaFile causes an FsBackend to read the named file, relative to its
+// directory, before any other file. This ensures the package meta, which is
+// conventionally stored in crossplane.yaml, is always parsed first. It is a
+// no-op if the file does not exist or is filtered.
+func MetaFile(name string) BackendOption {
+	return func(p Backend) {
+		f, ok := p.(*FsBackend)
+		if !ok {
+			return
+		}
+		f.meta = name
+	}
+}
+
 // EchoBackend is a parser backend that uses string input as source.
 type EchoBackend struct {
 	echo string
@@ -305,3 +818,107 @@ func (p *EchoBackend) Init(ctx context.Context, bo ...BackendOption) (io.ReadClo
 	}
 	return ioutil.NopCloser(strings.NewReader(p.echo)), nil
 }
+
+// MultiBackend is a parser backend that concatenates the streams of other
+// backends.
+type MultiBackend struct {
+	backends []Backend
+}
+
+// NewMultiBackend returns a MultiBackend that reads from each of the supplied
+// backends in order.
+func NewMultiBackend(backends ...Backend) *MultiBackend {
+	return &MultiBackend{backends: backends}
+}
+
+// Init initializes a MultiBackend by initializing each of its backends with
+// the supplied options. It returns an io.ReadCloser that reads each backend's
+// stream in turn, separated by a YAML document separator.
+func (p *MultiBackend) Init(ctx context.Context, bo ...BackendOption) (io.ReadCloser, error) {
+	rcs := make([]io.ReadCloser, 0, len(p.backends))
+	for _, b := range p.backends {
+		rc, err := b.Init(ctx, bo...)
+		if err != nil {
+			for _, rc := range rcs {
+				_ = rc.Close()
+			}
+			return nil, err
+		}
+		// Some backends, like the NopBackend, return no reader.
+		if rc == nil {
+			continue
+		}
+		rcs = append(rcs, rc)
+	}
+	return &MultiReadCloser{readers: rcs}, nil
+}
+
+var _ AnnotatedReadCloser = &MultiReadCloser{}
+
+// A MultiReadCloser reads from each of several io.ReadClosers in turn,
+// separated by a YAML document separator. Each io.ReadCloser is closed once
+// it has been read to EOF.
+type MultiReadCloser struct {
+	readers   []io.ReadCloser
+	index     int
+	separator []byte
+}
+
+// Read from the current io.ReadCloser, moving on to the next when it returns
+// io.EOF.
+func (r *MultiReadCloser) Read(p []byte) (int, error) {
+	for {
+		if len(r.separator) > 0 {
+			n := copy(p, r.separator)
+			r.separator = r.separator[n:]
+			return n, nil
+		}
+		if r.index == len(r.readers) {
+			return 0, io.EOF
+		}
+		n, err := r.readers[r.index].Read(p)
+		if !errors.Is(err, io.EOF) {
+			return n, err
+		}
+		// Move on before closing, so that Close doesn't close this reader
+		// again if closing it fails.
+		rc := r.readers[r.index]
+		r.index++
+		if r.index < len(r.readers) {
+			r.separator = []byte("\n---\n")
+		}
+		if cerr := rc.Close(); cerr != nil {
+			return n, cerr
+		}
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// Close closes any io.ReadClosers that have not yet been read to EOF.
+func (r *MultiReadCloser) Close() error {
+	var err error
+	for ; r.index < len(r.readers); r.index++ {
+		if cerr := r.readers[r.index].Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// Annotate returns the annotation of the io.ReadCloser currently being read,
+// if it is an AnnotatedReadCloser.
+func (r *MultiReadCloser) Annotate() any {
+	index := r.index
+	if index == len(r.readers) {
+		index--
+	}
+	if index < 0 {
+		return nil
+	}
+	if a, ok := r.readers[index].(AnnotatedReadCloser); ok {
+		return a.Annotate()
+	}
+	return nil
+}
@@ -18,20 +18,42 @@ package parser
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/klauspost/compress/zstd"
 	"github.com/spf13/afero"
 	appsv1 "k8s.io/api/apps/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/yaml"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 )
 
 var _ Parser = &PackageParser{}
 
+type fixedDecoder struct {
+	obj runtime.Object
+}
+
+func (d fixedDecoder) Decode(_ []byte, _ *schema.GroupVersionKind, _ runtime.Object) (runtime.Object, *schema.GroupVersionKind, error) {
+	gvk := d.obj.GetObjectKind().GroupVersionKind()
+	return d.obj, &gvk, nil
+}
+
 var (
 	crdBytes = []byte(`apiVersion: apiextensions.k8s.io/v1beta1
 kind: CustomResourceDefinition
@@ -58,12 +80,86 @@ kind: Deployment
 metadata:
   name: test`)
 
+	// Not all JSON is valid YAML; YAML does not support the \/ escape.
+	jsonDeployBytes = []byte(`{
+	"apiVersion": "apps/v1",
+	"kind": "Deployment",
+	"metadata": {
+		"name": "test",
+		"annotations": {"cool": "very\/cool"}
+	}
+}`)
+
 	crd    = &apiextensions.CustomResourceDefinition{}
 	_      = yaml.Unmarshal(crdBytes, crd)
 	deploy = &appsv1.Deployment{}
 	_      = yaml.Unmarshal(deployBytes, deploy)
+
+	jsonDeploy = &appsv1.Deployment{}
+	_          = json.Unmarshal(jsonDeployBytes, jsonDeploy)
+
+	convertedCRD = &extv1.CustomResourceDefinition{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "CustomResourceDefinition"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+	}
 )
 
+// newParser returns a PackageParser, ignoring any error. It's intended for use
+// in test cases that supply valid schemes.
+func newParser(meta, obj ObjectCreaterTyper, o ...PackageParserOption) *PackageParser {
+	p, _ := New(meta, obj, o...)
+	return p
+}
+
+func TestNew(t *testing.T) {
+	s := runtime.NewScheme()
+
+	type args struct {
+		meta ObjectCreaterTyper
+		obj  ObjectCreaterTyper
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   error
+	}{
+		"NilMetaScheme": {
+			reason: "We should return an error if the meta scheme is nil.",
+			args:   args{obj: s},
+			want:   errors.New(errNilMetaScheme),
+		},
+		"NilObjectScheme": {
+			reason: "We should return an error if the object scheme is nil.",
+			args:   args{meta: s},
+			want:   errors.New(errNilObjectScheme),
+		},
+		"NilSchemeBackend": {
+			reason: "We should return an error if a nil *runtime.Scheme is supplied.",
+			args:   args{meta: SchemeBackend(nil), obj: s},
+			want:   errors.New(errNilMetaScheme),
+		},
+		"NilScheme": {
+			reason: "We should return an error if a nil *runtime.Scheme is supplied without using SchemeBackend.",
+			args:   args{meta: s, obj: (*runtime.Scheme)(nil)},
+			want:   errors.New(errNilObjectScheme),
+		},
+		"Success": {
+			reason: "We should return a parser if both schemes are supplied.",
+			args:   args{meta: SchemeBackend(s), obj: SchemeBackend(s)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(tc.args.meta, tc.args.obj)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nNew(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestParser(t *testing.T) {
 	allBytes := bytes.Join([][]byte{crdBytes, deployBytes}, []byte("\n---\n"))
 	fs := afero.NewMemMapFs()
@@ -83,6 +179,25 @@ func TestParser(t *testing.T) {
 	_ = apiextensions.AddToScheme(objScheme)
 	metaScheme := runtime.NewScheme()
 	_ = appsv1.AddToScheme(metaScheme)
+	gzipBytes := &bytes.Buffer{}
+	gw := gzip.NewWriter(gzipBytes)
+	_, _ = gw.Write(allBytes)
+	_ = gw.Close()
+	zstdBytes := &bytes.Buffer{}
+	zw, _ := zstd.NewWriter(zstdBytes)
+	_, _ = zw.Write(allBytes)
+	_ = zw.Close()
+	// A zstd frame header declaring a 16MiB window, followed by an empty last
+	// raw block. See https://datatracker.ietf.org/doc/html/rfc8878#section-3.1.1.1
+	zstdLargeWindowBytes := []byte{0x28, 0xb5, 0x2f, 0xfd, 0x00, 0x70, 0x01, 0x00, 0x00}
+	tenant := func(o runtime.Object) error {
+		meta.AddLabels(o.(metav1.Object), map[string]string{"tenant": "cool"})
+		return nil
+	}
+	tenantCRD := crd.DeepCopy()
+	meta.AddLabels(tenantCRD, map[string]string{"tenant": "cool"})
+	tenantDeploy := deploy.DeepCopy()
+	meta.AddLabels(tenantDeploy, map[string]string{"tenant": "cool"})
 
 	cases := map[string]struct {
 		reason  string
@@ -93,35 +208,154 @@ func TestParser(t *testing.T) {
 	}{
 		"EchoBackendEmpty": {
 			reason:  "should have empty output with empty input",
-			parser:  New(metaScheme, objScheme),
+			parser:  newParser(metaScheme, objScheme),
 			backend: NewEchoBackend(""),
 			pkg:     NewPackage(),
 		},
 		"EchoBackendError": {
 			reason:  "should have error with invalid yaml",
-			parser:  New(metaScheme, objScheme),
+			parser:  newParser(metaScheme, objScheme),
 			backend: NewEchoBackend("definitely not yaml"),
 			pkg:     NewPackage(),
 			wantErr: true,
 		},
 		"EchoBackend": {
 			reason:  "should parse input stream successfully",
-			parser:  New(metaScheme, objScheme),
+			parser:  newParser(metaScheme, objScheme),
 			backend: NewEchoBackend(string(allBytes)),
 			pkg: &Package{
 				meta:    []runtime.Object{deploy},
 				objects: []runtime.Object{crd},
 			},
 		},
+		"EchoBackendGzip": {
+			reason:  "should parse a gzip compressed input stream successfully when decompression is enabled",
+			parser:  newParser(metaScheme, objScheme, WithDecompression()),
+			backend: NewEchoBackend(gzipBytes.String()),
+			pkg: &Package{
+				meta:    []runtime.Object{deploy},
+				objects: []runtime.Object{crd},
+			},
+		},
+		"EchoBackendZstd": {
+			reason:  "should parse a zstd compressed input stream successfully when decompression is enabled",
+			parser:  newParser(metaScheme, objScheme, WithDecompression()),
+			backend: NewEchoBackend(zstdBytes.String()),
+			pkg: &Package{
+				meta:    []runtime.Object{deploy},
+				objects: []runtime.Object{crd},
+			},
+		},
+		"EchoBackendZstdWindowTooLarge": {
+			reason:  "should have error with a zstd compressed input stream whose window exceeds the maximum",
+			parser:  newParser(metaScheme, objScheme, WithDecompression()),
+			backend: NewEchoBackend(string(zstdLargeWindowBytes)),
+			pkg:     NewPackage(),
+			wantErr: true,
+		},
+		"EchoBackendUncompressed": {
+			reason:  "should parse an uncompressed input stream successfully when decompression is enabled",
+			parser:  newParser(metaScheme, objScheme, WithDecompression()),
+			backend: NewEchoBackend(string(allBytes)),
+			pkg: &Package{
+				meta:    []runtime.Object{deploy},
+				objects: []runtime.Object{crd},
+			},
+		},
+		"EchoBackendCorruptGzip": {
+			reason:  "should have error with a corrupt gzip compressed input stream",
+			parser:  newParser(metaScheme, objScheme, WithDecompression()),
+			backend: NewEchoBackend(string(gzipBytes.Bytes()[:4])),
+			pkg:     NewPackage(),
+			wantErr: true,
+		},
+		"EchoBackendObjectDecoder": {
+			reason:  "should decode objects not recognized by the meta scheme using the supplied object decoder",
+			parser:  newParser(metaScheme, objScheme, WithObjectDecoder(fixedDecoder{obj: convertedCRD})),
+			backend: NewEchoBackend(string(allBytes)),
+			pkg: &Package{
+				meta:    []runtime.Object{deploy},
+				objects: []runtime.Object{convertedCRD},
+			},
+		},
+		"EchoBackendSingleMeta": {
+			reason:  "should parse input stream successfully when single meta is required and one meta object is present",
+			parser:  newParser(metaScheme, objScheme, WithSingleMeta()),
+			backend: NewEchoBackend(string(allBytes)),
+			pkg: &Package{
+				meta:    []runtime.Object{deploy},
+				objects: []runtime.Object{crd},
+			},
+		},
+		"EchoBackendJSON": {
+			reason:  "should parse JSON documents in a YAML stream successfully",
+			parser:  newParser(metaScheme, objScheme),
+			backend: NewEchoBackend(string(bytes.Join([][]byte{jsonDeployBytes, crdBytes}, []byte("\n---\n")))),
+			pkg: &Package{
+				meta:    []runtime.Object{jsonDeploy},
+				objects: []runtime.Object{crd},
+			},
+		},
+		"EchoBackendAllowedObjectKinds": {
+			reason:  "should parse input stream successfully when all objects are of allowed kinds",
+			parser:  newParser(metaScheme, objScheme, WithAllowedObjectKinds(apiextensions.SchemeGroupVersion.WithKind("CustomResourceDefinition"))),
+			backend: NewEchoBackend(string(allBytes)),
+			pkg: &Package{
+				meta:    []runtime.Object{deploy},
+				objects: []runtime.Object{crd},
+			},
+		},
+		"MultiBackend": {
+			reason:  "should parse the concatenated streams of multiple backends successfully",
+			parser:  newParser(metaScheme, objScheme),
+			backend: NewMultiBackend(NewEchoBackend(string(crdBytes)), NewNopBackend(), NewFsBackend(fs, FsFilters(SkipDirs(), SkipNotYAML(), SkipPath(".crossplane/*")))),
+			pkg: &Package{
+				meta:    []runtime.Object{deploy},
+				objects: []runtime.Object{crd, crd, crd, crd, crd},
+			},
+		},
+		"EchoBackendMetaOnly": {
+			reason:  "should skip documents not recognized by the meta scheme when parsing only meta",
+			parser:  newParser(metaScheme, objScheme, WithMetaOnly()),
+			backend: NewEchoBackend(string(allBytes) + "\n---\napiVersion: example.org/v1\nkind: Unknown"),
+			pkg: &Package{
+				meta: []runtime.Object{deploy},
+			},
+		},
+		"EchoBackendObjectMutator": {
+			reason:  "should mutate each object recognized by the object scheme before adding it to the package",
+			parser:  newParser(metaScheme, objScheme, WithObjectMutator(tenant)),
+			backend: NewEchoBackend(string(allBytes)),
+			pkg: &Package{
+				meta:    []runtime.Object{deploy},
+				objects: []runtime.Object{tenantCRD},
+			},
+		},
+		"EchoBackendMetaMutator": {
+			reason:  "should mutate each object recognized by the meta scheme before adding it to the package",
+			parser:  newParser(metaScheme, objScheme, WithMetaMutator(tenant)),
+			backend: NewEchoBackend(string(allBytes)),
+			pkg: &Package{
+				meta:    []runtime.Object{tenantDeploy},
+				objects: []runtime.Object{crd},
+			},
+		},
+		"EchoBackendMutatorError": {
+			reason:  "should have error if a mutator returns an error",
+			parser:  newParser(metaScheme, objScheme, WithObjectMutator(func(_ runtime.Object) error { return errors.New("boom") })),
+			backend: NewEchoBackend(string(allBytes)),
+			pkg:     NewPackage(),
+			wantErr: true,
+		},
 		"NopBackend": {
 			reason:  "should never parse any objects and never return an error",
-			parser:  New(metaScheme, objScheme),
+			parser:  newParser(metaScheme, objScheme),
 			backend: NewNopBackend(),
 			pkg:     NewPackage(),
 		},
 		"FsBackend": {
 			reason:  "should parse filesystem successfully",
-			parser:  New(metaScheme, objScheme),
+			parser:  newParser(metaScheme, objScheme),
 			backend: NewFsBackend(fs, FsDir("."), FsFilters(SkipDirs(), SkipNotYAML(), SkipPath(".crossplane/*"))),
 			pkg: &Package{
 				meta:    []runtime.Object{deploy},
@@ -130,7 +364,7 @@ func TestParser(t *testing.T) {
 		},
 		"FsBackendAll": {
 			reason:  "should parse filesystem successfully with multiple objects in single file",
-			parser:  New(metaScheme, objScheme),
+			parser:  newParser(metaScheme, objScheme),
 			backend: NewFsBackend(allFs, FsDir("."), FsFilters(SkipDirs(), SkipNotYAML(), SkipPath(".crossplane/*"))),
 			pkg: &Package{
 				meta:    []runtime.Object{deploy},
@@ -139,14 +373,14 @@ func TestParser(t *testing.T) {
 		},
 		"FsBackendError": {
 			reason:  "should error if yaml file with invalid yaml",
-			parser:  New(metaScheme, objScheme),
+			parser:  newParser(metaScheme, objScheme),
 			backend: NewFsBackend(fs, FsDir(".")),
 			pkg:     NewPackage(),
 			wantErr: true,
 		},
 		"FsBackendSkip": {
 			reason:  "should skip empty files and files without yaml extension",
-			parser:  New(metaScheme, objScheme),
+			parser:  newParser(metaScheme, objScheme),
 			backend: NewFsBackend(emptyFs, FsDir("."), FsFilters(SkipDirs(), SkipEmpty(), SkipNotYAML())),
 			pkg:     NewPackage(),
 		},
@@ -163,6 +397,9 @@ func TestParser(t *testing.T) {
 				t.Errorf("parser.Parse(...): unexpected error: %s", err)
 			}
 			if tc.wantErr {
+				if err == nil {
+					t.Errorf("parser.Parse(...): %s: expected error", tc.reason)
+				}
 				return
 			}
 			if diff := cmp.Diff(tc.pkg.GetObjects(), pkg.GetObjects(), cmpopts.SortSlices(func(i, j runtime.Object) bool {
@@ -178,3 +415,498 @@ func TestParser(t *testing.T) {
 		})
 	}
 }
+
+func TestFsBackendMetaFile(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	_ = afero.WriteFile(fs, "pkg/a.yaml", crdBytes, 0o644)
+	_ = afero.WriteFile(fs, "pkg/crossplane.yaml", deployBytes, 0o644)
+	_ = afero.WriteFile(fs, "pkg/z.yaml", crdBytes, 0o644)
+
+	cases := map[string]struct {
+		reason string
+		bo     []BackendOption
+		want   []byte
+	}{
+		"MetaFileFirst": {
+			reason: "The meta file should be read before any other file.",
+			bo:     []BackendOption{FsDir("pkg"), FsFilters(SkipDirs()), MetaFile("crossplane.yaml")},
+			want:   deployBytes,
+		},
+		"MetaFileMissing": {
+			reason: "Files should be read in lexical order if the meta file does not exist.",
+			bo:     []BackendOption{FsDir("pkg"), FsFilters(SkipDirs()), MetaFile("missing.yaml")},
+			want:   crdBytes,
+		},
+		"NoMetaFile": {
+			reason: "Files should be read in lexical order if no meta file is specified.",
+			bo:     []BackendOption{FsDir("pkg"), FsFilters(SkipDirs())},
+			want:   crdBytes,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := NewFsBackend(fs, tc.bo...).Init(context.TODO())
+			if err != nil {
+				t.Fatalf("backend.Init(...): unexpected error: %s", err)
+			}
+			b, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("io.ReadAll(...): unexpected error: %s", err)
+			}
+			if !bytes.HasPrefix(b, tc.want) {
+				t.Errorf("\n%s\nbackend.Init(...): want stream beginning with:\n%s\ngot:\n%s", tc.reason, tc.want, b)
+			}
+		})
+	}
+}
+
+type closeTracker struct {
+	io.Reader
+	closes int
+	err    error
+}
+
+func (c *closeTracker) Close() error {
+	c.closes++
+	return c.err
+}
+
+func TestMultiReadCloser(t *testing.T) {
+	a := &closeTracker{Reader: strings.NewReader("a: b")}
+	b := &closeTracker{Reader: strings.NewReader("")}
+	c := &closeTracker{Reader: strings.NewReader("c: d")}
+	r := &MultiReadCloser{readers: []io.ReadCloser{a, b, c}}
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("io.ReadAll(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff("a: b\n---\n\n---\nc: d", string(got)); diff != "" {
+		t.Errorf("io.ReadAll(...): -want, +got:\n%s", diff)
+	}
+	for i, rc := range []*closeTracker{a, b, c} {
+		if rc.closes != 1 {
+			t.Errorf("Read(...): reader %d should be closed once when read to EOF, was closed %d times", i, rc.closes)
+		}
+	}
+}
+
+func TestMultiReadCloserCloseError(t *testing.T) {
+	errBoom := errors.New("boom")
+	a := &closeTracker{Reader: strings.NewReader("a: b"), err: errBoom}
+	b := &closeTracker{Reader: strings.NewReader("c: d")}
+	r := &MultiReadCloser{readers: []io.ReadCloser{a, b}}
+
+	if _, err := io.ReadAll(r); !errors.Is(err, errBoom) {
+		t.Fatalf("io.ReadAll(...): want error %s, got %v", errBoom, err)
+	}
+	_ = r.Close()
+	for i, rc := range []*closeTracker{a, b} {
+		if rc.closes != 1 {
+			t.Errorf("Close(): reader %d should be closed once, was closed %d times", i, rc.closes)
+		}
+	}
+}
+
+func TestAnnotateErr(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		reader io.ReadCloser
+		want   error
+	}{
+		"NotAnnotated": {
+			reason: "Errors from a reader that is not an AnnotatedReadCloser should not be annotated.",
+			reader: &closeTracker{Reader: strings.NewReader("")},
+			want:   errBoom,
+		},
+		"NilAnnotation": {
+			reason: "Errors from an AnnotatedReadCloser with a nil annotation should not be annotated.",
+			reader: &MultiReadCloser{readers: []io.ReadCloser{&closeTracker{Reader: strings.NewReader("")}}},
+			want:   errBoom,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := annotateErr(errBoom, tc.reader)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nannotateErr(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestParserMultipleMeta(t *testing.T) {
+	metaScheme := runtime.NewScheme()
+	_ = appsv1.AddToScheme(metaScheme)
+	objScheme := runtime.NewScheme()
+
+	multiBytes := bytes.Join([][]byte{deployBytes, deployBytes}, []byte("\n---\n"))
+
+	cases := map[string]struct {
+		reason string
+		parser Parser
+		want   error
+	}{
+		"SingleMeta": {
+			reason: "should return ErrMultipleMeta if more than one meta object is present",
+			parser: newParser(metaScheme, objScheme, WithSingleMeta()),
+			want:   ErrMultipleMeta,
+		},
+		"MultipleMetaAllowed": {
+			reason: "should not return an error if more than one meta object is present and single meta is not required",
+			parser: newParser(metaScheme, objScheme),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, _ := NewEchoBackend(string(multiBytes)).Init(context.TODO())
+			_, err := tc.parser.Parse(context.TODO(), r)
+			if diff := cmp.Diff(tc.want, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nparser.Parse(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestParserProgress(t *testing.T) {
+	metaScheme := runtime.NewScheme()
+	_ = appsv1.AddToScheme(metaScheme)
+	objScheme := runtime.NewScheme()
+	_ = apiextensions.AddToScheme(objScheme)
+
+	docs := make([]int, 0)
+	bytesRead := make([]int, 0)
+	p := newParser(metaScheme, objScheme, WithProgress(func(d, b int) {
+		docs = append(docs, d)
+		bytesRead = append(bytesRead, b)
+	}))
+
+	in := bytes.Join([][]byte{whitespaceBytes, deployBytes}, []byte("\n---\n"))
+	r, _ := NewEchoBackend(string(in)).Init(context.TODO())
+	if _, err := p.Parse(context.TODO(), r); err != nil {
+		t.Fatalf("parser.Parse(...): %s", err)
+	}
+
+	// Empty documents should not be reported as parsed.
+	if diff := cmp.Diff([]int{1, 2, 3}, docs); diff != "" {
+		t.Errorf("parser.Parse(...): -want documents parsed, +got documents parsed:\n%s", diff)
+	}
+	for i := range bytesRead {
+		if bytesRead[i] <= 0 || bytesRead[i] > len(in) || (i > 0 && bytesRead[i] <= bytesRead[i-1]) {
+			t.Errorf("parser.Parse(...): bytes read should increase with each document parsed: got %v", bytesRead)
+			break
+		}
+	}
+}
+
+func TestExternalNames(t *testing.T) {
+	cluster := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "cool"}}
+	meta.SetExternalName(cluster, "cool-external")
+	namespaced := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cool"}}
+	meta.SetExternalName(namespaced, "cooler-external")
+	unnamed := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "unnamed"}}
+
+	cases := map[string]struct {
+		reason string
+		pkg    *Package
+		want   map[string]string
+	}{
+		"EmptyPackage": {
+			reason: "An empty package should have no external names.",
+			pkg:    NewPackage(),
+			want:   map[string]string{},
+		},
+		"ManagedResources": {
+			reason: "The external name of each managed resource should be returned, skipping other objects.",
+			pkg:    NewPackageWith([]runtime.Object{deploy}, []runtime.Object{cluster, namespaced, unnamed, crd}),
+			want: map[string]string{
+				"cool":         "cool-external",
+				"default/cool": "cooler-external",
+				"unnamed":      "",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ExternalNames(tc.pkg)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nExternalNames(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestParserDisallowedObjectKind(t *testing.T) {
+	metaScheme := runtime.NewScheme()
+	objScheme := runtime.NewScheme()
+	_ = apiextensions.AddToScheme(objScheme)
+
+	cases := map[string]struct {
+		reason string
+		parser Parser
+		want   *DisallowedObjectKindError
+	}{
+		"Disallowed": {
+			reason: "should return a DisallowedObjectKindError if an object is not of an allowed kind",
+			parser: newParser(metaScheme, objScheme, WithAllowedObjectKinds(appsv1.SchemeGroupVersion.WithKind("Deployment"))),
+			want:   &DisallowedObjectKindError{GVK: apiextensions.SchemeGroupVersion.WithKind("CustomResourceDefinition")},
+		},
+		"NoAllowedKinds": {
+			reason: "should allow all kinds if no allowed kinds are supplied",
+			parser: newParser(metaScheme, objScheme, WithAllowedObjectKinds()),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, _ := NewEchoBackend(string(crdBytes)).Init(context.TODO())
+			_, err := tc.parser.Parse(context.TODO(), r)
+
+			var got *DisallowedObjectKindError
+			_ = errors.As(err, &got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nparser.Parse(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDocumentDepth(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		doc    string
+		want   int
+	}{
+		"Flat": {
+			reason: "A document with only top level fields should have a depth of one.",
+			doc:    "apiVersion: v1\nkind: ConfigMap",
+			want:   1,
+		},
+		"Nested": {
+			reason: "Indented mappings should be counted.",
+			doc:    "metadata:\n  labels:\n    cool: very\n",
+			want:   3,
+		},
+		"Sequences": {
+			reason: "Nested sequence entries on one line should be counted.",
+			doc:    "items:\n  - - - a\n",
+			want:   4,
+		},
+		"Flow": {
+			reason: "Flow collections should be counted.",
+			doc:    "a: {b: [c, {d: e}]}",
+			want:   4,
+		},
+		"JSON": {
+			reason: "JSON documents should not be counted twice for their indentation and braces.",
+			doc:    "{\n  \"a\": {\n    \"b\": [1, 2]\n  }\n}",
+			want:   3,
+		},
+		"Quoted": {
+			reason: "Brackets within quoted strings should not be counted.",
+			doc:    "a: \"[[[{{{\"\nb: '[[['",
+			want:   1,
+		},
+		"ApostropheInPlainKey": {
+			reason: "An apostrophe within a plain scalar should not start a quoted string.",
+			doc:    "a'b: " + strings.Repeat("[", 500),
+			want:   501,
+		},
+		"ApostropheInPlainValue": {
+			reason: "An apostrophe within a plain scalar value should not start a quoted string.",
+			doc:    "a: it's\nb: [[c]]",
+			want:   3,
+		},
+		"EscapedSingleQuote": {
+			reason: "An escaped quote should not end a single quoted string.",
+			doc:    "a: 'it''s [[['\nb: [c]",
+			want:   2,
+		},
+		"CompactJSON": {
+			reason: "A quoted value immediately following the colon of a quoted key should be treated as quoted.",
+			doc:    `{"a":"[[[","b":[1]}`,
+			want:   2,
+		},
+		"MultiLineDoubleQuoted": {
+			reason: "Brackets within a multi-line double quoted string should not be counted.",
+			doc:    "a: \"b\n  [[[\n  c\" \nd: [[e]]",
+			want:   3,
+		},
+		"MultiLineSingleQuoted": {
+			reason: "Brackets within a multi-line single quoted string should not be counted, but those following it should.",
+			doc:    "a: ['b\n  [[[\n  c', [d]]",
+			want:   3,
+		},
+		"BlockScalar": {
+			reason: "The content of block scalars should not be counted.",
+			doc:    "a: |\n  b:\n    c:\n      d: e\nf: g",
+			want:   1,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := documentDepth([]byte(tc.doc))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ndocumentDepth(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestParserMaxDepth(t *testing.T) {
+	metaScheme := runtime.NewScheme()
+	_ = appsv1.AddToScheme(metaScheme)
+	objScheme := runtime.NewScheme()
+	_ = apiextensions.AddToScheme(objScheme)
+
+	deep := []byte("apiVersion: apiextensions.k8s.io/v1beta1\nkind: CustomResourceDefinition\nmetadata:\n  name: test\nspec: {names: {categories: [a]}}")
+	in := bytes.Join([][]byte{crdBytes, deep}, []byte("\n---\n"))
+
+	cases := map[string]struct {
+		reason string
+		parser Parser
+		want   error
+	}{
+		"TooDeep": {
+			reason: "should return ErrDocumentTooDeep if a document is nested deeper than the maximum depth",
+			parser: newParser(metaScheme, objScheme, WithMaxDepth(3)),
+			want:   errors.Wrap(ErrDocumentTooDeep, "document 2"),
+		},
+		"WithinLimit": {
+			reason: "should not return an error if no document is nested deeper than the maximum depth",
+			parser: newParser(metaScheme, objScheme, WithMaxDepth(10)),
+		},
+		"Disabled": {
+			reason: "should not limit nesting depth by default",
+			parser: newParser(metaScheme, objScheme),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, _ := NewEchoBackend(string(in)).Init(context.TODO())
+			_, err := tc.parser.Parse(context.TODO(), r)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nparser.Parse(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want != nil && !errors.Is(err, ErrDocumentTooDeep) {
+				t.Errorf("\n%s\nparser.Parse(...): want error to be ErrDocumentTooDeep", tc.reason)
+			}
+		})
+	}
+}
+
+func TestParserCollectErrors(t *testing.T) {
+	metaScheme := runtime.NewScheme()
+	_ = appsv1.AddToScheme(metaScheme)
+	objScheme := runtime.NewScheme()
+	_ = apiextensions.AddToScheme(objScheme)
+
+	unknownBytes := []byte("apiVersion: example.org/v1\nkind: Unknown")
+	in := bytes.Join([][]byte{crdBytes, unknownBytes, deployBytes, unknownBytes, crdBytes}, []byte("\n---\n"))
+
+	type want struct {
+		meta    int
+		objects int
+		errs    int
+	}
+
+	cases := map[string]struct {
+		reason string
+		parser Parser
+		want   want
+	}{
+		"CollectErrors": {
+			reason: "should return every document that could be parsed, and an error for each that could not",
+			parser: newParser(metaScheme, objScheme, WithCollectErrors()),
+			want:   want{meta: 1, objects: 2, errs: 2},
+		},
+		"FailFast": {
+			reason: "should stop parsing at the first document that cannot be parsed by default",
+			parser: newParser(metaScheme, objScheme),
+			want:   want{meta: 0, objects: 1, errs: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, _ := NewEchoBackend(string(in)).Init(context.TODO())
+			pkg, err := tc.parser.Parse(context.TODO(), r)
+			if err == nil {
+				t.Fatalf("\n%s\nparser.Parse(...): want error, got nil", tc.reason)
+			}
+
+			errs := 1
+			me := &errors.MultiError{}
+			if errors.As(err, &me) {
+				errs = len(me.Errors())
+			}
+			got := want{meta: len(pkg.GetMeta()), objects: len(pkg.GetObjects()), errs: errs}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nparser.Parse(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestParserRawRetention(t *testing.T) {
+	metaScheme := runtime.NewScheme()
+	_ = appsv1.AddToScheme(metaScheme)
+	objScheme := runtime.NewScheme()
+	_ = apiextensions.AddToScheme(objScheme)
+
+	in := bytes.Join([][]byte{crdBytes, deployBytes}, []byte("\n---\n"))
+
+	type want struct {
+		meta    string
+		objects []string
+		ok      bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		parser Parser
+		want   want
+	}{
+		"RetainRaw": {
+			reason: "should retain the raw document each object was decoded from",
+			parser: newParser(metaScheme, objScheme, WithRawRetention()),
+			want:   want{meta: string(deployBytes) + "\n", objects: []string{string(crdBytes) + "\n"}, ok: true},
+		},
+		"DiscardRaw": {
+			reason: "should not retain raw documents by default",
+			parser: newParser(metaScheme, objScheme),
+			want:   want{objects: []string{""}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, _ := NewEchoBackend(string(in)).Init(context.TODO())
+			pkg, err := tc.parser.Parse(context.TODO(), r)
+			if err != nil {
+				t.Fatalf("\n%s\nparser.Parse(...): %s", tc.reason, err)
+			}
+
+			m, ok := pkg.RawFor(pkg.GetMeta()[0])
+			got := want{meta: string(m), ok: ok}
+			for _, o := range pkg.GetObjects() {
+				raw, _ := pkg.RawFor(o)
+				got.objects = append(got.objects, string(raw))
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\npkg.RawFor(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if _, ok := pkg.RawFor(deploy); ok {
+				t.Errorf("\n%s\npkg.RawFor(...): want false for an object not in the package, got true", tc.reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parser
+
+import (
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+const (
+	errFmtConvertSchema = "cannot convert schema of CRD %s version %s"
+	errFmtNewValidator  = "cannot create schema validator for CRD %s version %s"
+	errToUnstructured   = "cannot convert object to unstructured"
+	errFmtInvalidObject = "%s %q is invalid"
+)
+
+var _ Linter = &SchemaValidator{}
+
+// A SchemaValidator validates objects against the OpenAPI schemas of the
+// CustomResourceDefinitions that define them.
+type SchemaValidator struct {
+	validators map[schema.GroupVersionKind]*validate.SchemaValidator
+}
+
+// NewSchemaValidator returns a SchemaValidator that validates objects against
+// the schemas of the supplied CustomResourceDefinitions. The CRDs may be those
+// of the package being validated.
+func NewSchemaValidator(crds []extv1.CustomResourceDefinition) (*SchemaValidator, error) {
+	sv := &SchemaValidator{validators: make(map[schema.GroupVersionKind]*validate.SchemaValidator)}
+	for _, crd := range crds {
+		for _, v := range crd.Spec.Versions {
+			if v.Schema == nil {
+				continue
+			}
+			in := &extv1.CustomResourceValidation{}
+			v.Schema.DeepCopyInto(in)
+			out := &apiextensions.CustomResourceValidation{}
+			if err := extv1.Convert_v1_CustomResourceValidation_To_apiextensions_CustomResourceValidation(in, out, nil); err != nil {
+				return nil, errors.Wrapf(err, errFmtConvertSchema, crd.GetName(), v.Name)
+			}
+			val, _, err := validation.NewSchemaValidator(out)
+			if err != nil {
+				return nil, errors.Wrapf(err, errFmtNewValidator, crd.GetName(), v.Name)
+			}
+			sv.validators[schema.GroupVersionKind{Group: crd.Spec.Group, Version: v.Name, Kind: crd.Spec.Names.Kind}] = val
+		}
+	}
+	return sv, nil
+}
+
+// Validate the supplied object against the schema of its kind. All of the
+// ways in which the object violates the schema are returned as one error.
+// Objects of kinds defined by none of the SchemaValidator's CRDs are not
+// validated.
+func (sv *SchemaValidator) Validate(o runtime.Object) error {
+	gvk := o.GetObjectKind().GroupVersionKind()
+	val, ok := sv.validators[gvk]
+	if !ok {
+		return nil
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+	if err != nil {
+		return errors.Wrap(err, errToUnstructured)
+	}
+	name := ""
+	if mo, ok := o.(metav1.Object); ok {
+		name = mo.GetName()
+	}
+	if err := validation.ValidateCustomResource(nil, u, val).ToAggregate(); err != nil {
+		return errors.Wrapf(err, errFmtInvalidObject, gvk.Kind, name)
+	}
+	return nil
+}
+
+// Lint validates all objects in the supplied package, returning an error that
+// aggregates the errors of every invalid object.
+func (sv *SchemaValidator) Lint(pkg *Package) error {
+	errs := errors.NewMultiError()
+	for _, o := range pkg.GetObjects() {
+		errs.Add(sv.Validate(o))
+	}
+	return errs.ErrorOrNil()
+}
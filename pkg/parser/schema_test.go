@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func coolCRD() extv1.CustomResourceDefinition {
+	one := float64(1)
+	return extv1.CustomResourceDefinition{
+		Spec: extv1.CustomResourceDefinitionSpec{
+			Group: "example.org",
+			Names: extv1.CustomResourceDefinitionNames{Kind: "Cool"},
+			Versions: []extv1.CustomResourceDefinitionVersion{
+				{
+					Name: "v1",
+					Schema: &extv1.CustomResourceValidation{
+						OpenAPIV3Schema: &extv1.JSONSchemaProps{
+							Type: "object",
+							Properties: map[string]extv1.JSONSchemaProps{
+								"spec": {
+									Type:     "object",
+									Required: []string{"replicas"},
+									Properties: map[string]extv1.JSONSchemaProps{
+										"replicas": {Type: "integer", Minimum: &one},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					// Versions without a schema should be ignored.
+					Name: "v2",
+				},
+			},
+		},
+	}
+}
+
+func coolObject(apiVersion string, spec map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": apiVersion,
+		"kind":       "Cool",
+		"metadata":   map[string]any{"name": "cool"},
+		"spec":       spec,
+	}}
+}
+
+func TestSchemaValidatorLint(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		objects []runtime.Object
+		want    error
+	}{
+		"Valid": {
+			reason:  "Objects that satisfy their CRD's schema should be valid.",
+			objects: []runtime.Object{coolObject("example.org/v1", map[string]any{"replicas": int64(3)})},
+		},
+		"NoSchema": {
+			reason: "Objects of kinds or versions that have no schema should not be validated.",
+			objects: []runtime.Object{
+				coolObject("example.org/v2", map[string]any{"replicas": "many"}),
+				coolObject("other.org/v1", map[string]any{"replicas": "many"}),
+			},
+		},
+		"Invalid": {
+			reason: "Every way in which every object violates its CRD's schema should be returned.",
+			objects: []runtime.Object{
+				coolObject("example.org/v1", map[string]any{"replicas": "many"}),
+				coolObject("example.org/v1", map[string]any{}),
+			},
+			want: func() error {
+				errs := errors.NewMultiError()
+				errs.Add(errors.Wrapf(errors.New(`spec.replicas: Invalid value: "string": spec.replicas in body must be of type integer: "string"`), errFmtInvalidObject, "Cool", "cool"))
+				errs.Add(errors.Wrapf(errors.New(`spec.replicas: Required value`), errFmtInvalidObject, "Cool", "cool"))
+				return errs
+			}(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			sv, err := NewSchemaValidator([]extv1.CustomResourceDefinition{coolCRD()})
+			if err != nil {
+				t.Fatalf("\n%s\nNewSchemaValidator(...): %s", tc.reason, err)
+			}
+			err = sv.Lint(NewPackageWith(nil, tc.objects))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nLint(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -24,10 +24,12 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store/kubernetes"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -101,13 +103,31 @@ func NewAPISecretPublisher(c client.Client, ot runtime.ObjectTyper) *APISecretPu
 
 // PublishConnection publishes the supplied ConnectionDetails to a Secret in the
 // same namespace as the supplied Managed resource. It is a no-op if the secret
-// already exists with the supplied ConnectionDetails.
+// already exists with the supplied ConnectionDetails. It returns an error
+// wrapping ErrInvalidConnectionKey without writing the Secret if any of the
+// supplied ConnectionDetails has a key that is invalid in a Secret, and one
+// wrapping an ErrConnectionDetailsTooLarge if the supplied ConnectionDetails
+// exceed the maximum size of a Secret.
 func (a *APISecretPublisher) PublishConnection(ctx context.Context, o resource.ConnectionSecretOwner, c ConnectionDetails) (bool, error) {
 	// This resource does not want to expose a connection secret.
 	if o.GetWriteConnectionSecretToReference() == nil {
 		return false, nil
 	}
 
+	if err := ValidateConnectionKeys(c); err != nil {
+		return false, errors.Wrap(err, errCreateOrUpdateSecret)
+	}
+
+	// Check the size up front, as the Kubernetes SecretStore does, rather than
+	// relying on the API server's opaque rejection of an oversized Secret.
+	sz := 0
+	for _, v := range c {
+		sz += len(v)
+	}
+	if sz > corev1.MaxSecretSize {
+		return false, errors.Wrap(&kubernetes.ErrConnectionDetailsTooLarge{Size: sz, Limit: corev1.MaxSecretSize}, errCreateOrUpdateSecret)
+	}
+
 	s := resource.ConnectionSecretFor(o, resource.MustGetKind(o, a.typer))
 	s.Data = c
 	err := a.secret.Apply(ctx, s,
@@ -177,23 +197,41 @@ func (a *APISimpleReferenceResolver) ResolveReferences(ctx context.Context, mg r
 // A RetryingCriticalAnnotationUpdater is a CriticalAnnotationUpdater that
 // retries annotation updates in the face of API server errors.
 type RetryingCriticalAnnotationUpdater struct {
-	client client.Client
+	client  client.Client
+	backoff wait.Backoff
+}
+
+// A RetryingCriticalAnnotationUpdaterOption configures a
+// RetryingCriticalAnnotationUpdater.
+type RetryingCriticalAnnotationUpdaterOption func(*RetryingCriticalAnnotationUpdater)
+
+// WithRetryBackoff specifies how a RetryingCriticalAnnotationUpdater should
+// back off between attempts to update critical annotations. The default is
+// retry.DefaultRetry.
+func WithRetryBackoff(b wait.Backoff) RetryingCriticalAnnotationUpdaterOption {
+	return func(u *RetryingCriticalAnnotationUpdater) {
+		u.backoff = b
+	}
 }
 
 // NewRetryingCriticalAnnotationUpdater returns a CriticalAnnotationUpdater that
 // retries annotation updates in the face of API server errors.
-func NewRetryingCriticalAnnotationUpdater(c client.Client) *RetryingCriticalAnnotationUpdater {
-	return &RetryingCriticalAnnotationUpdater{client: c}
+func NewRetryingCriticalAnnotationUpdater(c client.Client, o ...RetryingCriticalAnnotationUpdaterOption) *RetryingCriticalAnnotationUpdater {
+	u := &RetryingCriticalAnnotationUpdater{client: c, backoff: retry.DefaultRetry}
+	for _, fn := range o {
+		fn(u)
+	}
+	return u
 }
 
 // UpdateCriticalAnnotations updates (i.e. persists) the annotations of the
-// supplied Object. It retries in the face of any API server error several times
-// in order to ensure annotations that contain critical state are persisted. Any
-// pending changes to the supplied Object's spec, status, or other metadata are
-// reset to their current state according to the API server.
+// supplied Object. It retries in the face of any API server error according to
+// its backoff in order to ensure annotations that contain critical state are
+// persisted. Any pending changes to the supplied Object's spec, status, or
+// other metadata are reset to their current state according to the API server.
 func (u *RetryingCriticalAnnotationUpdater) UpdateCriticalAnnotations(ctx context.Context, o client.Object) error {
 	a := o.GetAnnotations()
-	err := retry.OnError(retry.DefaultRetry, resource.IsAPIError, func() error {
+	err := retry.OnError(u.backoff, resource.IsAPIError, func() error {
 		nn := types.NamespacedName{Name: o.GetName()}
 		if err := u.client.Get(ctx, nn, o); err != nil {
 			return err
@@ -18,14 +18,22 @@ package managed
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store/kubernetes"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -284,6 +292,42 @@ func TestAPISecretPublisher(t *testing.T) {
 				published: true,
 			},
 		},
+		"InvalidKey": {
+			reason: "A connection detail with a key that is invalid in a Secret should return an error without applying the secret",
+			fields: fields{
+				secret: resource.ApplyFn(func(_ context.Context, _ client.Object, _ ...resource.ApplyOption) error {
+					t.Errorf("Apply should not be called when a connection detail key is invalid")
+					return nil
+				}),
+				typer: fake.SchemeWith(&fake.Managed{}),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mg,
+				c:   ConnectionDetails{"cool/key": {42}},
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrapf(errors.Wrap(ErrInvalidConnectionKey, strings.Join(validation.IsConfigMapKey("cool/key"), "; ")), errFmtInvalidConnectionKey, "cool/key"), errCreateOrUpdateSecret),
+			},
+		},
+		"TooLarge": {
+			reason: "Connection details that exceed the maximum size of a Secret should return an error without applying the secret",
+			fields: fields{
+				secret: resource.ApplyFn(func(_ context.Context, _ client.Object, _ ...resource.ApplyOption) error {
+					t.Errorf("Apply should not be called when connection details are too large")
+					return nil
+				}),
+				typer: fake.SchemeWith(&fake.Managed{}),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mg,
+				c:   ConnectionDetails{"a": make([]byte, corev1.MaxSecretSize), "b": {42}},
+			},
+			want: want{
+				err: errors.Wrap(&kubernetes.ErrConnectionDetailsTooLarge{Size: corev1.MaxSecretSize + 1, Limit: corev1.MaxSecretSize}, errCreateOrUpdateSecret),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -430,11 +474,16 @@ func TestRetryingCriticalAnnotationUpdater(t *testing.T) {
 		o   client.Object
 	}
 
+	errConflict := kerrors.NewConflict(schema.GroupResource{}, "", errBoom)
+	gets := 0
+
 	cases := map[string]struct {
-		reason string
-		c      client.Client
-		args   args
-		want   error
+		reason   string
+		c        client.Client
+		o        []RetryingCriticalAnnotationUpdaterOption
+		args     args
+		want     error
+		wantGets int
 	}{
 		"GetError": {
 			reason: "We should return any error we encounter getting the supplied object",
@@ -457,6 +506,23 @@ func TestRetryingCriticalAnnotationUpdater(t *testing.T) {
 			},
 			want: errors.Wrap(errBoom, errUpdateCriticalAnnotations),
 		},
+		"RetryBackoff": {
+			reason: "We should stop retrying and return the last error once the supplied backoff is exhausted",
+			c: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, _ client.Object) error {
+					gets++
+					return errConflict
+				},
+			},
+			o: []RetryingCriticalAnnotationUpdaterOption{
+				WithRetryBackoff(wait.Backoff{Steps: 3, Duration: time.Millisecond}),
+			},
+			args: args{
+				o: &fake.Managed{},
+			},
+			want:     errors.Wrap(errConflict, errUpdateCriticalAnnotations),
+			wantGets: 3,
+		},
 		"Success": {
 			reason: "We should return without error if we successfully update our annotations",
 			c: &test.MockClient{
@@ -472,11 +538,15 @@ func TestRetryingCriticalAnnotationUpdater(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			u := NewRetryingCriticalAnnotationUpdater(tc.c)
+			gets = 0
+			u := NewRetryingCriticalAnnotationUpdater(tc.c, tc.o...)
 			got := u.UpdateCriticalAnnotations(tc.args.ctx, tc.args.o)
 			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\nu.UpdateCriticalAnnotations(...): -want, +got:\n%s", tc.reason, diff)
 			}
+			if tc.wantGets != 0 && gets != tc.wantGets {
+				t.Errorf("\n%s\nu.UpdateCriticalAnnotations(...): want %d attempts, got %d", tc.reason, tc.wantGets, gets)
+			}
 		})
 	}
 }
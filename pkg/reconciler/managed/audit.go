@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// An AuditAction is an action a Reconciler takes against an external
+// resource.
+type AuditAction string
+
+// Audit actions.
+const (
+	// AuditActionNone indicates the Reconciler did not attempt to create,
+	// update, or delete the external resource.
+	AuditActionNone AuditAction = "None"
+
+	// AuditActionCreate indicates the Reconciler attempted to create the
+	// external resource.
+	AuditActionCreate AuditAction = "Create"
+
+	// AuditActionUpdate indicates the Reconciler attempted to update the
+	// external resource.
+	AuditActionUpdate AuditAction = "Update"
+
+	// AuditActionDelete indicates the Reconciler attempted to delete the
+	// external resource.
+	AuditActionDelete AuditAction = "Delete"
+)
+
+// An AuditRecord records the decision a Reconciler made while reconciling a
+// managed resource.
+type AuditRecord struct {
+	// GVK of the managed resource.
+	GVK schema.GroupVersionKind
+
+	// Name of the managed resource.
+	Name types.NamespacedName
+
+	// Action the Reconciler attempted against the external resource. The
+	// action may have failed.
+	Action AuditAction
+
+	// Reason the Reconciler took (or did not take) the action.
+	Reason string
+
+	// Error is nil if the reconcile succeeded. Otherwise it describes why the
+	// reconcile failed, per the managed resource's Synced condition, or the
+	// error the Reconciler returned.
+	Error error
+}
+
+// An AuditSink is called with an AuditRecord once per reconcile of a managed
+// resource that exists.
+type AuditSink func(ctx context.Context, r AuditRecord)
+
+// Audit reasons.
+const (
+	auditReasonPaused        = "Reconciliation is paused via the pause annotation"
+	auditReasonNotDeleted    = "Managed resource was deleted but its external resource should not be"
+	auditReasonDeleted       = "Managed resource was deleted"
+	auditReasonNotExist      = "External resource does not exist"
+	auditReasonObserveOnly   = "External resources are only observed"
+	auditReasonUpToDate      = "External resource is up to date"
+	auditReasonNotUpToDate   = "External resource is not up to date"
+	auditReasonNotReconciled = "Reconcile did not reach a decision"
+)
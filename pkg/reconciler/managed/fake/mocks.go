@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides fake managed reconciler dependencies for use in tests.
+package fake
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+var (
+	_ managed.ExternalClient              = &ExternalClient{}
+	_ managed.ExternalConnectDisconnecter = &Connecter{}
+)
+
+// ExternalClient is a mock that implements managed.ExternalClient. Any
+// function that is not set does nothing and returns zero values.
+type ExternalClient struct {
+	MockObserve func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error)
+	MockCreate  func(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error)
+	MockUpdate  func(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error)
+	MockDelete  func(ctx context.Context, mg resource.Managed) error
+}
+
+// Observe calls MockObserve, if set.
+func (c *ExternalClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	if c.MockObserve == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	return c.MockObserve(ctx, mg)
+}
+
+// Create calls MockCreate, if set.
+func (c *ExternalClient) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if c.MockCreate == nil {
+		return managed.ExternalCreation{}, nil
+	}
+	return c.MockCreate(ctx, mg)
+}
+
+// Update calls MockUpdate, if set.
+func (c *ExternalClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if c.MockUpdate == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	return c.MockUpdate(ctx, mg)
+}
+
+// Delete calls MockDelete, if set.
+func (c *ExternalClient) Delete(ctx context.Context, mg resource.Managed) error {
+	if c.MockDelete == nil {
+		return nil
+	}
+	return c.MockDelete(ctx, mg)
+}
+
+// Connecter is a mock that implements managed.ExternalConnectDisconnecter.
+// Connect returns Client, or an empty ExternalClient if Client is not set,
+// unless MockConnect is set. Any function that is not set does nothing.
+type Connecter struct {
+	Client managed.ExternalClient
+
+	MockConnect    func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error)
+	MockDisconnect func(ctx context.Context) error
+}
+
+// Connect calls MockConnect, if set. Otherwise it returns Client.
+func (c *Connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if c.MockConnect != nil {
+		return c.MockConnect(ctx, mg)
+	}
+	if c.Client == nil {
+		return &ExternalClient{}, nil
+	}
+	return c.Client, nil
+}
+
+// Disconnect calls MockDisconnect, if set.
+func (c *Connecter) Disconnect(ctx context.Context) error {
+	if c.MockDisconnect == nil {
+		return nil
+	}
+	return c.MockDisconnect(ctx)
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type reconcileInfoKey struct{}
+
+// ReconcileInfo describes the reconcile of a managed resource. The Reconciler
+// adds it to the context passed to its ExternalConnecter and ExternalClient.
+type ReconcileInfo struct {
+	// UID of the managed resource being reconciled.
+	UID types.UID
+
+	// GVK of the managed resource being reconciled.
+	GVK schema.GroupVersionKind
+
+	// ProviderConfigName is the name of the provider config referenced by the
+	// managed resource, if any.
+	ProviderConfigName string
+
+	// Attempt is the number of consecutive times reconciliation of the managed
+	// resource has been attempted, starting at one. It is reset when a
+	// reconcile succeeds.
+	Attempt int
+
+	// LateInitializationDisabled is true if the Reconciler will not persist
+	// changes an ExternalClient makes to the spec of the managed resource
+	// while observing it. ExternalClients may use it to skip late
+	// initialization.
+	LateInitializationDisabled bool
+}
+
+// WithInfo returns a copy of the supplied context that carries the supplied
+// ReconcileInfo.
+func WithInfo(ctx context.Context, i ReconcileInfo) context.Context {
+	return context.WithValue(ctx, reconcileInfoKey{}, i)
+}
+
+// InfoFromContext returns the ReconcileInfo carried by the supplied context,
+// if any.
+func InfoFromContext(ctx context.Context) (ReconcileInfo, bool) {
+	i, ok := ctx.Value(reconcileInfoKey{}).(ReconcileInfo)
+	return i, ok
+}
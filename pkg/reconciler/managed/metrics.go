@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// A MetricRecorder records metrics about the managed resources a Reconciler
+// reconciles.
+type MetricRecorder interface {
+	// RecordFirstTimeReady records that the supplied managed resource of the
+	// supplied kind became ready for the first time.
+	RecordFirstTimeReady(gvk schema.GroupVersionKind, mg resource.Managed)
+}
+
+// A NopMetricRecorder does nothing.
+type NopMetricRecorder struct{}
+
+// RecordFirstTimeReady does nothing.
+func (NopMetricRecorder) RecordFirstTimeReady(_ schema.GroupVersionKind, _ resource.Managed) {}
+
+// An MRMetricRecorder records metrics about managed resources using
+// Prometheus. It must be registered with a Prometheus registry, for example
+// controller-runtime's metrics.Registry, in order for its metrics to be
+// exposed.
+type MRMetricRecorder struct {
+	firstReady *prometheus.HistogramVec
+}
+
+// NewMRMetricRecorder returns a new MRMetricRecorder.
+func NewMRMetricRecorder() *MRMetricRecorder {
+	return &MRMetricRecorder{
+		firstReady: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "managed_resource_first_ready_seconds",
+			Help:    "The time it took for a managed resource to become ready for the first time, measured from its creation.",
+			Buckets: []float64{1, 5, 10, 15, 30, 60, 120, 300, 600, 1800, 3600},
+		}, []string{"gvk"}),
+	}
+}
+
+// RecordFirstTimeReady records the time elapsed between the creation of the
+// supplied managed resource and now.
+func (r *MRMetricRecorder) RecordFirstTimeReady(gvk schema.GroupVersionKind, mg resource.Managed) {
+	r.firstReady.WithLabelValues(gvk.String()).Observe(time.Since(mg.GetCreationTimestamp().Time).Seconds())
+}
+
+// Describe sends the descriptors of this recorder's metrics to the supplied
+// channel.
+func (r *MRMetricRecorder) Describe(ch chan<- *prometheus.Desc) {
+	r.firstReady.Describe(ch)
+}
+
+// Collect sends this recorder's metrics to the supplied channel.
+func (r *MRMetricRecorder) Collect(ch chan<- prometheus.Metric) {
+	r.firstReady.Collect(ch)
+}
+
+// A readinessTracker tracks which managed resources have not been ready since
+// they were created. It only knows about resources it observed before they
+// first became ready, so resources that were still becoming ready when the
+// process started are never considered ready for the first time.
+type readinessTracker struct {
+	mx sync.Mutex
+
+	// created maps each managed resource we've observed that has not yet been
+	// ready to its creation timestamp, in case it is deleted and recreated.
+	created map[types.NamespacedName]metav1.Time
+}
+
+// observe the supplied managed resource before it is reconciled. A resource
+// with no Ready condition, or that is still being created, has never been
+// ready.
+func (t *readinessTracker) observe(n types.NamespacedName, mg resource.Managed) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	c := mg.GetCondition(xpv1.TypeReady)
+	switch {
+	case c.Reason == "" || c.Reason == xpv1.ReasonCreating:
+		t.created[n] = mg.GetCreationTimestamp()
+	case c.Status == corev1.ConditionTrue:
+		delete(t.created, n)
+	}
+}
+
+// readyForFirstTime returns true if the supplied managed resource is ready,
+// and has not been ready since it was created. It returns true at most once
+// for each resource.
+func (t *readinessTracker) readyForFirstTime(n types.NamespacedName, mg resource.Managed) bool {
+	if mg.GetCondition(xpv1.TypeReady).Status != corev1.ConditionTrue {
+		return false
+	}
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	created, ok := t.created[n]
+	delete(t.created, n)
+	ts := mg.GetCreationTimestamp()
+	return ok && created.Equal(&ts)
+}
+
+// forget the supplied managed resource, for example because it was deleted.
+func (t *readinessTracker) forget(n types.NamespacedName) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	delete(t.created, n)
+}
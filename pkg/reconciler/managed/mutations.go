@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// TrackMutations snapshots the supplied managed resource. It returns the
+// managed resource, and a function that reports the paths of any fields that
+// have been added, removed, or changed since the snapshot was taken. Paths are
+// JSON pointers, for example /spec/forProvider/region. The function returns
+// nil if the managed resource cannot be converted to unstructured data. It is
+// intended for debugging.
+func TrackMutations(mg resource.Managed) (resource.Managed, func() []string) {
+	snapshot := mg.DeepCopyObject()
+	return mg, func() []string {
+		from, err := runtime.DefaultUnstructuredConverter.ToUnstructured(snapshot)
+		if err != nil {
+			return nil
+		}
+		to, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+		if err != nil {
+			return nil
+		}
+		ops, err := fieldpath.DiffAsJSONPatch(from, to)
+		if err != nil {
+			return nil
+		}
+		paths := make([]string, len(ops))
+		for i := range ops {
+			paths[i] = ops[i].Path
+		}
+		return paths
+	}
+}
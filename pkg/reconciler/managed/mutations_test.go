@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func TestTrackMutations(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		mutate func(mg resource.Managed)
+		want   []string
+	}{
+		"NoMutations": {
+			reason: "No paths should be reported if the managed resource was not mutated.",
+			mg:     &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "cool"}},
+			mutate: func(_ resource.Managed) {},
+			want:   []string{},
+		},
+		"Mutations": {
+			reason: "The paths of mutated fields should be reported.",
+			mg:     &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "cool", Annotations: map[string]string{"cool": "very"}}},
+			mutate: func(mg resource.Managed) {
+				mg.SetName("cooler")
+				meta.SetExternalName(mg, "cool-external")
+			},
+			// The fake managed resource's ObjectMeta has no JSON tag.
+			want: []string{
+				"/objectMeta/annotations/crossplane.io~1external-name",
+				"/objectMeta/name",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg, mutated := TrackMutations(tc.mg)
+			tc.mutate(mg)
+			if diff := cmp.Diff(tc.want, mutated()); diff != "" {
+				t.Errorf("\n%s\nmutated(): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -18,12 +18,98 @@ package managed
 
 import (
 	"context"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 )
 
-const errSecretStoreDisabled = "cannot publish to secret store, feature is not enabled"
+const (
+	errSecretStoreDisabled = "cannot publish to secret store, feature is not enabled"
+
+	errFmtInvalidConnectionKey = "connection detail %q"
+	errFmtConnectionKeyClash   = "sanitized connection details %q and %q have the same key %q"
+)
+
+// ErrInvalidConnectionKey is returned when a connection detail's key is not a
+// valid Kubernetes Secret key. Secret keys may consist only of alphanumeric
+// characters, '-', '_', or '.'.
+var ErrInvalidConnectionKey = errors.New("invalid connection secret key")
+
+// ValidateConnectionKeys returns an error wrapping ErrInvalidConnectionKey if
+// any of the supplied connection details has a key that is not a valid
+// Kubernetes Secret key.
+func ValidateConnectionKeys(c ConnectionDetails) error {
+	for _, k := range sortedConnectionKeys(c) {
+		if msgs := validation.IsConfigMapKey(k); len(msgs) > 0 {
+			return errors.Wrapf(errors.Wrap(ErrInvalidConnectionKey, strings.Join(msgs, "; ")), errFmtInvalidConnectionKey, k)
+		}
+	}
+	return nil
+}
+
+// SanitizeConnectionKeys returns a function that may be passed to
+// WithConnectionTransformer in order to make the keys of connection details
+// valid Kubernetes Secret keys. Each character of a key that may not appear in
+// a Secret key is replaced with the character returned by the supplied
+// mapping. The character is dropped if the mapping returns a negative value.
+// The function returns an error if sanitizing causes two connection details to
+// have the same key.
+func SanitizeConnectionKeys(mapping func(r rune) rune) func(ctx context.Context, mg resource.Managed, in ConnectionDetails) (ConnectionDetails, error) {
+	return func(_ context.Context, _ resource.Managed, in ConnectionDetails) (ConnectionDetails, error) {
+		out := make(ConnectionDetails, len(in))
+		from := make(map[string]string, len(in))
+		for _, k := range sortedConnectionKeys(in) {
+			sk := strings.Map(func(r rune) rune {
+				if isConnectionKeyRune(r) {
+					return r
+				}
+				return mapping(r)
+			}, k)
+			if prev, ok := from[sk]; ok {
+				return nil, errors.Errorf(errFmtConnectionKeyClash, prev, k, sk)
+			}
+			from[sk] = k
+			out[sk] = in[k]
+		}
+		return out, nil
+	}
+}
+
+// MergeConnectionDetails returns connection details that contain the details
+// of both dst and src. The value of a key that appears in both is taken from
+// src, i.e. the last writer wins. Neither dst nor src are modified, and either
+// may be nil. MergeConnectionDetails returns nil if both are empty.
+func MergeConnectionDetails(dst, src ConnectionDetails) ConnectionDetails {
+	if len(dst)+len(src) == 0 {
+		return nil
+	}
+	out := make(ConnectionDetails, len(dst)+len(src))
+	for k, v := range dst {
+		out[k] = v
+	}
+	for k, v := range src {
+		out[k] = v
+	}
+	return out
+}
+
+func isConnectionKeyRune(r rune) bool {
+	return r == '-' || r == '_' || r == '.' ||
+		(r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
+
+func sortedConnectionKeys(c ConnectionDetails) []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
 
 // A PublisherChain chains multiple ManagedPublishers.
 type PublisherChain []ConnectionPublisher
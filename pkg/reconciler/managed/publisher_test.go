@@ -18,9 +18,12 @@ package managed
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
@@ -203,3 +206,123 @@ func TestDisabledSecretStoreUnpublish(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConnectionKeys(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		c      ConnectionDetails
+		want   error
+	}{
+		"ValidKeys": {
+			reason: "Keys consisting of alphanumeric characters, '-', '_', and '.' should be valid.",
+			c:      ConnectionDetails{"cool-key_1.txt": {42}},
+		},
+		"InvalidKey": {
+			reason: "An error naming the first invalid key should be returned.",
+			c:      ConnectionDetails{"valid": {42}, "in/valid": {42}, "z/invalid": {42}},
+			want:   errors.Wrapf(errors.Wrap(ErrInvalidConnectionKey, strings.Join(validation.IsConfigMapKey("in/valid"), "; ")), errFmtInvalidConnectionKey, "in/valid"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateConnectionKeys(tc.c)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateConnectionKeys(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want != nil && !errors.Is(err, ErrInvalidConnectionKey) {
+				t.Errorf("\n%s\nValidateConnectionKeys(...): want error wrapping ErrInvalidConnectionKey, got %s", tc.reason, err)
+			}
+		})
+	}
+}
+
+func TestMergeConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		dst    ConnectionDetails
+		src    ConnectionDetails
+		want   ConnectionDetails
+	}{
+		"BothNil": {
+			reason: "Merging nil connection details should return nil.",
+		},
+		"NilDst": {
+			reason: "Merging into nil connection details should return the source details.",
+			src:    ConnectionDetails{"a": []byte("src")},
+			want:   ConnectionDetails{"a": []byte("src")},
+		},
+		"NilSrc": {
+			reason: "Merging nil connection details should return the destination details.",
+			dst:    ConnectionDetails{"a": []byte("dst")},
+			want:   ConnectionDetails{"a": []byte("dst")},
+		},
+		"LastWriterWins": {
+			reason: "Keys that appear only once should be kept, and keys that appear in both should take the source value.",
+			dst:    ConnectionDetails{"a": []byte("dst"), "b": []byte("dst")},
+			src:    ConnectionDetails{"b": []byte("src"), "c": []byte("src")},
+			want:   ConnectionDetails{"a": []byte("dst"), "b": []byte("src"), "c": []byte("src")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dst := MergeConnectionDetails(nil, tc.dst)
+			got := MergeConnectionDetails(tc.dst, tc.src)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nMergeConnectionDetails(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(dst, tc.dst, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nMergeConnectionDetails(...): dst should not be modified: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSanitizeConnectionKeys(t *testing.T) {
+	underscore := func(_ rune) rune { return '_' }
+	drop := func(_ rune) rune { return -1 }
+
+	type want struct {
+		c   ConnectionDetails
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		mapping func(r rune) rune
+		c       ConnectionDetails
+		want    want
+	}{
+		"Replace": {
+			reason:  "Invalid characters should be replaced with the character returned by the mapping.",
+			mapping: underscore,
+			c:       ConnectionDetails{"projects/cool/key": {42}, "valid": {43}},
+			want:    want{c: ConnectionDetails{"projects_cool_key": {42}, "valid": {43}}},
+		},
+		"Drop": {
+			reason:  "Invalid characters should be dropped if the mapping returns a negative value.",
+			mapping: drop,
+			c:       ConnectionDetails{"cool:key": {42}},
+			want:    want{c: ConnectionDetails{"coolkey": {42}}},
+		},
+		"Clash": {
+			reason:  "An error should be returned if two keys are the same once sanitized.",
+			mapping: underscore,
+			c:       ConnectionDetails{"cool/key": {42}, "cool_key": {43}},
+			want:    want{err: errors.Errorf(errFmtConnectionKeyClash, "cool/key", "cool_key", "cool_key")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := SanitizeConnectionKeys(tc.mapping)(context.Background(), &fake.Managed{}, tc.c)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nSanitizeConnectionKeys(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.c, got); diff != "" {
+				t.Errorf("\n%s\nSanitizeConnectionKeys(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
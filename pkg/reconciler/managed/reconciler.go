@@ -18,19 +18,29 @@ package managed
 
 import (
 	"context"
+	"reflect"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/conditions"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 )
 
@@ -56,6 +66,11 @@ const (
 	errReconcileCreate          = "create failed"
 	errReconcileUpdate          = "update failed"
 	errReconcileDelete          = "delete failed"
+	errReconcilePreDelete       = "pre-delete failed"
+	errReconcileTransform       = "connection details transform failed"
+
+	errExternalResourceNotExist = "external resource does not exist"
+	errExternalResourceDrifted  = "external resource differs from desired state"
 )
 
 // Event reasons.
@@ -76,6 +91,10 @@ const (
 	reasonCreated event.Reason = "CreatedExternalResource"
 	reasonUpdated event.Reason = "UpdatedExternalResource"
 	reasonPending event.Reason = "PendingExternalResource"
+	reasonDrifted event.Reason = "DriftedExternalResource"
+
+	reasonPanicRecovered       event.Reason = "PanicRecovered"
+	reasonReconciliationPaused event.Reason = "ReconciliationPaused"
 )
 
 // ControllerName returns the recommended name for controllers that use this
@@ -84,6 +103,20 @@ func ControllerName(kind string) string {
 	return "managed/" + strings.ToLower(kind)
 }
 
+// ManagedGVKs returns the kinds registered with the supplied scheme whose Go
+// types implement resource.Managed, sorted by their string representation.
+func ManagedGVKs(s *runtime.Scheme) []schema.GroupVersionKind {
+	mt := reflect.TypeOf((*resource.Managed)(nil)).Elem()
+	gvks := make([]schema.GroupVersionKind, 0)
+	for gvk, t := range s.AllKnownTypes() {
+		if reflect.PtrTo(t).Implements(mt) {
+			gvks = append(gvks, gvk)
+		}
+	}
+	sort.Slice(gvks, func(i, j int) bool { return gvks[i].String() < gvks[j].String() })
+	return gvks
+}
+
 // A CriticalAnnotationUpdater is used when it is critical that annotations must
 // be updated before returning from the Reconcile loop.
 type CriticalAnnotationUpdater interface {
@@ -403,6 +436,14 @@ type ExternalObservation struct {
 	// finding where the observed diverges from the desired state.
 	// The string should be a cmp.Diff that details the difference.
 	Diff string
+
+	// DriftDetails optionally describe how the external resource differs
+	// from the desired state of the managed resource when ResourceUpToDate
+	// is false, for example by listing the fields that have drifted. Unlike
+	// Diff, Crossplane surfaces these details to operators by emitting an
+	// event, and by including them in the condition message of a managed
+	// resource that is only observed.
+	DriftDetails []string
 }
 
 // An ExternalCreation is the result of the creation of an external resource.
@@ -441,11 +482,38 @@ type ExternalUpdate struct {
 // for which it is responsible.
 type Reconciler struct {
 	client     client.Client
+	gvk        schema.GroupVersionKind
 	newManaged func() resource.Managed
 
 	pollInterval        time.Duration
 	timeout             time.Duration
+	maxTimeout          time.Duration
 	creationGracePeriod time.Duration
+	observeOnly         bool
+	panicRecovery       bool
+	trackMutations      bool
+	pauseAnnotation     bool
+	pausedQuietly       bool
+	observeBeforeDelete bool
+	lateInitDisabled    bool
+
+	preDelete func(ctx context.Context, mg resource.Managed) error
+
+	transformConnection func(ctx context.Context, mg resource.Managed, in ConnectionDetails) (ConnectionDetails, error)
+
+	failureBackoff func(errorCount int) time.Duration
+	errorCounts    *errorCounter
+	firstReady     *readinessTracker
+
+	metrics    MetricRecorder
+	conditions conditions.Manager
+
+	maxConditionMessage int
+	observedGeneration  func(mg resource.Managed) int64
+
+	externalNameAnnotation string
+
+	audit AuditSink
 
 	// The below structs embed the set of interfaces used to implement the
 	// managed resource reconciler. We do this primarily for readability, so
@@ -495,13 +563,25 @@ type ReconcilerOption func(*Reconciler)
 // WithTimeout specifies the timeout duration cumulatively for all the calls happen
 // in the reconciliation function. In case the deadline exceeds, reconciler will
 // still have some time to make the necessary calls to report the error such as
-// status update.
+// status update. A managed resource may override this timeout using the
+// crossplane.io/reconcile-timeout annotation if WithMaxReconcileTimeout is
+// specified.
 func WithTimeout(duration time.Duration) ReconcilerOption {
 	return func(r *Reconciler) {
 		r.timeout = duration
 	}
 }
 
+// WithMaxReconcileTimeout allows managed resources to override the timeout
+// specified by WithTimeout using the crossplane.io/reconcile-timeout
+// annotation. Overrides longer than the supplied maximum are reduced to it.
+// The annotation is ignored by default.
+func WithMaxReconcileTimeout(max time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.maxTimeout = max
+	}
+}
+
 // WithPollInterval specifies how long the Reconciler should wait before queueing
 // a new reconciliation after a successful reconcile. The Reconciler requeues
 // after a specified duration when it is not actively waiting for an external
@@ -513,6 +593,20 @@ func WithPollInterval(after time.Duration) ReconcilerOption {
 	}
 }
 
+// WithFailureBackoff specifies how long the Reconciler should wait before
+// queueing a new reconciliation after a reconcile fails. The supplied function
+// is called with the number of consecutive times reconciliation of a managed
+// resource has failed, and returns how long to wait. The count is reset when
+// a reconcile succeeds. Reconciles that requeue because they are waiting, for
+// example for a newly created external resource to become observable, are not
+// considered to have failed. By default the Reconciler requeues failed
+// reconciles immediately, subject to the controller's rate limiter.
+func WithFailureBackoff(b func(errorCount int) time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.failureBackoff = b
+	}
+}
+
 // WithCreationGracePeriod configures an optional period during which we will
 // wait for the external API to report that a newly created external resource
 // exists. This allows us to tolerate eventually consistent APIs that do not
@@ -524,6 +618,131 @@ func WithCreationGracePeriod(d time.Duration) ReconcilerOption {
 	}
 }
 
+// WithObserveOnly specifies that the Reconciler should only observe external
+// resources. It will never create, update, or delete an external resource,
+// regardless of what it observes or the managed resource's deletion policy.
+// Instead it reports whether the external resource is up to date using the
+// UpToDate condition.
+func WithObserveOnly() ReconcilerOption {
+	return func(r *Reconciler) {
+		r.observeOnly = true
+	}
+}
+
+// WithPanicRecovery specifies that the Reconciler should recover from panics in
+// calls to an ExternalClient's Observe, Create, Update, and Delete methods.
+// A recovered panic is reported as a Synced condition with reason
+// PanicRecovered and a warning event that includes the stack trace, and the
+// managed resource is requeued. By default such panics are not recovered.
+func WithPanicRecovery() ReconcilerOption {
+	return func(r *Reconciler) {
+		r.panicRecovery = true
+	}
+}
+
+// WithMutationTracking specifies that the Reconciler should log the paths of
+// any fields of a managed resource that were mutated while reconciling it. It
+// is intended for debugging, for example to determine why a managed resource
+// is repeatedly updated.
+func WithMutationTracking() ReconcilerOption {
+	return func(r *Reconciler) {
+		r.trackMutations = true
+	}
+}
+
+// WithPauseAnnotation specifies that the Reconciler should not reconcile a
+// managed resource that is paused via the crossplane.io/paused annotation.
+// Paused resources are marked as not synced and are not requeued until the
+// annotation is removed or changed.
+func WithPauseAnnotation() ReconcilerOption {
+	return func(r *Reconciler) {
+		r.pauseAnnotation = true
+	}
+}
+
+// WithoutPausedStatusUpdates specifies that the Reconciler should not update
+// the status of a managed resource that is paused via the crossplane.io/paused
+// annotation. By default the Reconciler marks paused resources as not synced.
+// With this option it returns immediately without writing to the API server,
+// including events, and without requeueing. A paused resource is reconciled
+// again only when a watch event (e.g. removing the annotation) triggers it. Its
+// conditions and observed state may thus be stale while it is paused. This
+// option implies WithPauseAnnotation.
+func WithoutPausedStatusUpdates() ReconcilerOption {
+	return func(r *Reconciler) {
+		r.pauseAnnotation = true
+		r.pausedQuietly = true
+	}
+}
+
+// WithLateInitializationDisabled specifies that the Reconciler should never
+// persist changes an ExternalClient makes to the spec of a managed resource
+// while observing it, even if the ExternalClient reports that it late
+// initialized the managed resource. This is useful when the spec of managed
+// resources is managed entirely by another source of truth, for example a
+// GitOps tool, that would otherwise be in conflict with the Reconciler. The
+// Reconciler tells ExternalClients that late initialization is disabled via
+// the ReconcileInfo it adds to their context. Note that the Reconciler does not
+// revert changes an ExternalClient makes to the spec while observing, so any
+// subsequent call to Create or Update during the same reconcile sees the
+// late initialized spec. ExternalClients that should not act on late
+// initialized values must skip late initialization when it is disabled.
+func WithLateInitializationDisabled() ReconcilerOption {
+	return func(r *Reconciler) {
+		r.lateInitDisabled = true
+	}
+}
+
+// WithObserveBeforeDelete specifies that the Reconciler should observe the
+// external resource again immediately before it deletes it. The Reconciler
+// skips the call to Delete and proceeds to remove the managed resource's
+// finalizer if the external resource no longer exists. This is useful for
+// providers whose Delete implementations do not tolerate external resources
+// that were already deleted.
+func WithObserveBeforeDelete() ReconcilerOption {
+	return func(r *Reconciler) {
+		r.observeBeforeDelete = true
+	}
+}
+
+// WithConnectionTransformer specifies a function the Reconciler should use to
+// transform the connection details returned by an ExternalClient's Observe,
+// Create, or Update methods before they are published. This is useful for
+// deriving connection details from others, for example assembling a
+// kubeconfig from an endpoint and credentials. The Reconciler will not publish
+// connection details if the function returns an error.
+func WithConnectionTransformer(fn func(ctx context.Context, mg resource.Managed, in ConnectionDetails) (ConnectionDetails, error)) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.transformConnection = fn
+	}
+}
+
+// WithConditionManager specifies how the Reconciler should set the status
+// conditions of the managed resources it reconciles.
+func WithConditionManager(m conditions.Manager) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.conditions = m
+	}
+}
+
+// WithMetricRecorder specifies how the Reconciler should record metrics about
+// the managed resources it reconciles.
+func WithMetricRecorder(m MetricRecorder) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.metrics = m
+	}
+}
+
+// WithPreDelete specifies a function the Reconciler should call before it
+// deletes an external resource. The function is called only when the managed
+// resource has been deleted and its external resource exists. If it returns an
+// error the Reconciler requeues without deleting the external resource.
+func WithPreDelete(fn func(ctx context.Context, mg resource.Managed) error) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.preDelete = fn
+	}
+}
+
 // WithExternalConnecter specifies how the Reconciler should connect to the API
 // used to sync and delete external resources.
 func WithExternalConnecter(c ExternalConnecter) ReconcilerOption {
@@ -550,6 +769,16 @@ func WithCriticalAnnotationUpdater(u CriticalAnnotationUpdater) ReconcilerOption
 	}
 }
 
+// WithCriticalAnnotationBackoff specifies how the Reconciler should back off
+// between attempts to persist a managed resource's critical annotations. It
+// replaces the Reconciler's CriticalAnnotationUpdater with a
+// RetryingCriticalAnnotationUpdater that uses the supplied backoff.
+func WithCriticalAnnotationBackoff(b wait.Backoff) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.managed.CriticalAnnotationUpdater = NewRetryingCriticalAnnotationUpdater(r.client, WithRetryBackoff(b))
+	}
+}
+
 // WithConnectionPublishers specifies how the Reconciler should publish
 // its connection details such as credentials and endpoints.
 func WithConnectionPublishers(p ...ConnectionPublisher) ReconcilerOption {
@@ -596,6 +825,54 @@ func WithRecorder(er event.Recorder) ReconcilerOption {
 	}
 }
 
+// WithExternalNameAnnotation specifies an annotation key under which the
+// Reconciler should read and write the external name of a managed resource, in
+// addition to the standard crossplane.io/external-name annotation. This is
+// useful for providers that historically stored the external name under a
+// different annotation. If a managed resource has no standard external name
+// annotation the Reconciler sets it (in memory) to the value of the supplied
+// annotation, if any, before it is passed to an ExternalClient. When an
+// ExternalClient's Create method sets the external name the Reconciler
+// persists it under both annotations.
+func WithExternalNameAnnotation(key string) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.externalNameAnnotation = key
+	}
+}
+
+// WithAuditSink specifies a function the Reconciler should call once per
+// reconcile of an existing managed resource with a record of what it decided
+// to do with the external resource, and why. The sink is called synchronously
+// before Reconcile returns, so it should not block.
+func WithAuditSink(fn AuditSink) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.audit = fn
+	}
+}
+
+// WithObservedGenerationFunc specifies a function that returns the generation
+// the Reconciler should record as the observed generation of the status
+// conditions it sets on a managed resource. This is useful for managed
+// resources whose meaningful generation is that of another object, for example
+// a template they reference. The managed resource's metadata.generation is used
+// by default.
+func WithObservedGenerationFunc(fn func(mg resource.Managed) int64) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.observedGeneration = fn
+	}
+}
+
+// WithMaxConditionMessageLength specifies the maximum length, in bytes, of
+// the messages of the status conditions the Reconciler sets. Longer messages,
+// for example those containing verbose errors returned by an external system,
+// are truncated. Messages are truncated to xpv1.DefaultMaxMessageLength by
+// default, and are not truncated if the supplied length is zero or less.
+func WithMaxConditionMessageLength(n int) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.maxConditionMessage = n
+	}
+}
+
 // NewReconciler returns a Reconciler that reconciles managed resources of the
 // supplied ManagedKind with resources in an external system such as a cloud
 // provider API. It panics if asked to reconcile a managed resource kind that is
@@ -614,6 +891,7 @@ func NewReconciler(m manager.Manager, of resource.ManagedKind, o ...ReconcilerOp
 
 	r := &Reconciler{
 		client:              m.GetClient(),
+		gvk:                 schema.GroupVersionKind(of),
 		newManaged:          nm,
 		pollInterval:        defaultpollInterval,
 		creationGracePeriod: defaultGracePeriod,
@@ -622,39 +900,153 @@ func NewReconciler(m manager.Manager, of resource.ManagedKind, o ...ReconcilerOp
 		external:            defaultMRExternal(),
 		log:                 logging.NewNopLogger(),
 		record:              event.NewNopRecorder(),
+		errorCounts:         &errorCounter{counts: make(map[types.NamespacedName]int)},
+		firstReady:          &readinessTracker{created: make(map[types.NamespacedName]metav1.Time)},
+		metrics:             NopMetricRecorder{},
+		conditions:          conditions.New(),
+		observedGeneration:  func(mg resource.Managed) int64 { return mg.GetGeneration() },
+		maxConditionMessage: xpv1.DefaultMaxMessageLength,
 	}
 
 	for _, ro := range o {
 		ro(r)
 	}
 
+	r.conditions = conditions.NewObservedGenerationManager(r.conditions, r.conditionGeneration)
+	r.conditions = conditions.NewTruncatingManager(r.conditions, r.maxConditionMessage)
+
 	return r
 }
 
+// publishConnection transforms (if necessary) and publishes the supplied
+// connection details.
+func (r *Reconciler) publishConnection(ctx context.Context, mg resource.Managed, cd ConnectionDetails) (bool, error) {
+	if r.transformConnection != nil {
+		var err error
+		if cd, err = r.transformConnection(ctx, mg, cd); err != nil {
+			return false, errors.Wrap(err, errReconcileTransform)
+		}
+	}
+	return r.managed.PublishConnection(ctx, mg, cd)
+}
+
+// readExternalName sets the standard external name annotation of the supplied
+// managed resource to the value of the configured external name annotation, if
+// the standard annotation is empty.
+func (r *Reconciler) readExternalName(mg resource.Managed) {
+	if r.externalNameAnnotation == "" || meta.GetExternalName(mg) != "" {
+		return
+	}
+	if en := mg.GetAnnotations()[r.externalNameAnnotation]; en != "" {
+		meta.SetExternalName(mg, en)
+	}
+}
+
+// writeExternalName sets the configured external name annotation of the
+// supplied managed resource to the value of the standard annotation.
+func (r *Reconciler) writeExternalName(mg resource.Managed) {
+	if r.externalNameAnnotation == "" || meta.GetExternalName(mg) == "" {
+		return
+	}
+	meta.AddAnnotations(mg, map[string]string{r.externalNameAnnotation: meta.GetExternalName(mg)})
+}
+
+// conditionGeneration returns the observed generation of the status conditions
+// of the supplied object.
+func (r *Reconciler) conditionGeneration(o resource.Object) int64 {
+	if mg, ok := o.(resource.Managed); ok {
+		return r.observedGeneration(mg)
+	}
+	return o.GetGeneration()
+}
+
+// emitAudit calls the configured AuditSink, if any, with the supplied record.
+func (r *Reconciler) emitAudit(ctx context.Context, a AuditRecord) {
+	if r.audit == nil {
+		return
+	}
+	r.audit(ctx, a)
+}
+
 // Reconcile a managed resource with an external resource.
-func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) { // nolint:gocyclo
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	failed := false
+	result, err := r.reconcile(ctx, req, &failed)
+	if !failed && err == nil {
+		r.errorCounts.reset(req.NamespacedName)
+		return result, nil
+	}
+	n := r.errorCounts.inc(req.NamespacedName)
+
+	// We don't requeue a failed reconcile that didn't ask to be requeued, for
+	// example because it can't proceed without human intervention.
+	if r.failureBackoff == nil || (err == nil && !result.Requeue) {
+		return result, err
+	}
+
+	// The controller ignores the result of a reconcile that returns an error,
+	// so we must swallow the error in order to requeue after our backoff.
+	if err != nil {
+		r.log.Info("Cannot reconcile managed resource", "request", req, "error", err)
+	}
+	return reconcile.Result{RequeueAfter: r.failureBackoff(n)}, nil
+}
+
+// reconcile a managed resource with an external resource. It sets failed to
+// true if the Synced condition it set on the managed resource indicates that
+// reconciliation failed, regardless of whether it returned an error.
+func (r *Reconciler) reconcile(ctx context.Context, req reconcile.Request, failed *bool) (result reconcile.Result, err error) { // nolint:gocyclo
 	// NOTE(negz): This method is a well over our cyclomatic complexity goal.
 	// Be wary of adding additional complexity.
 
 	log := r.log.WithValues("request", req)
 	log.Debug("Reconciling")
 
-	ctx, cancel := context.WithTimeout(ctx, r.timeout+reconcileGracePeriod)
+	parent := ctx
+	ctx, cancel := context.WithTimeout(parent, r.timeout+reconcileGracePeriod)
 	defer cancel()
 
-	// Govet linter has a check for lost cancel funcs but it's a false positive
-	// for child contexts as because parent's cancel is called, so we skip it
-	// for this line.
-	externalCtx, _ := context.WithTimeout(ctx, r.timeout) // nolint:govet
-
 	managed := r.newManaged()
 	if err := r.client.Get(ctx, req.NamespacedName, managed); err != nil {
 		// There's no need to requeue if we no longer exist. Otherwise we'll be
 		// requeued implicitly because we return an error.
 		log.Debug("Cannot get managed resource", "error", err)
+		if kerrors.IsNotFound(err) {
+			r.firstReady.forget(req.NamespacedName)
+		}
 		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetManaged)
 	}
 
+	// The managed resource may override our timeout via an annotation, if we
+	// allow it to, in which case we derive a new deadline for the rest of
+	// this reconcile.
+	timeout := r.timeout
+	if t := meta.GetReconcileTimeout(managed); r.maxTimeout > 0 && t > 0 {
+		timeout = t
+		if timeout > r.maxTimeout {
+			timeout = r.maxTimeout
+		}
+		ctx, cancel = context.WithTimeout(parent, timeout+reconcileGracePeriod)
+		defer cancel()
+	}
+
+	externalCtx, externalCancel := context.WithTimeout(ctx, timeout)
+	defer externalCancel()
+
+	info := ReconcileInfo{
+		UID:     managed.GetUID(),
+		GVK:     r.gvk,
+		Attempt: r.errorCounts.get(req.NamespacedName) + 1,
+
+		LateInitializationDisabled: r.lateInitDisabled,
+	}
+	if ref := managed.GetProviderConfigReference(); ref != nil {
+		info.ProviderConfigName = ref.Name
+	}
+	externalCtx = WithInfo(externalCtx, info)
+
+	r.readExternalName(managed)
+
 	record := r.record.WithAnnotations("external-name", meta.GetExternalName(managed))
 	log = log.WithValues(
 		"uid", managed.GetUID(),
@@ -662,11 +1054,57 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		"external-name", meta.GetExternalName(managed),
 	)
 
+	// If the managed resource is paused we don't want to do anything with it,
+	// including handling its deletion. We'll be queued again when the pause
+	// annotation is changed.
+	if r.pauseAnnotation && meta.IsPaused(managed) {
+		log.Debug("Reconciliation is paused via the pause annotation")
+		r.emitAudit(ctx, AuditRecord{GVK: r.gvk, Name: req.NamespacedName, Action: AuditActionNone, Reason: auditReasonPaused})
+		if r.pausedQuietly {
+			return reconcile.Result{}, nil
+		}
+		record.Event(managed, event.Normal(reasonReconciliationPaused, "Reconciliation is paused via the pause annotation"))
+		r.conditions.MarkConditions(managed, xpv1.ReconcilePaused())
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+	}
+
+	// Every path below that fails sets a Synced condition that says so.
+	// Paths that don't set a Synced condition complete deletion, so the
+	// managed resource won't be reconciled again.
+	defer func() { *failed = reconcileFailed(managed) }()
+
+	audit := AuditRecord{GVK: r.gvk, Name: req.NamespacedName, Action: AuditActionNone, Reason: auditReasonNotReconciled}
+	defer func() {
+		if reconcileFailed(managed) {
+			audit.Error = errors.New(managed.GetCondition(xpv1.TypeSynced).Message)
+		} else if err != nil {
+			audit.Error = err
+		}
+		r.emitAudit(ctx, audit)
+	}()
+
+	// We only record that the managed resource became ready once we've
+	// successfully updated its status to say so.
+	r.firstReady.observe(req.NamespacedName, managed)
+	defer func() {
+		if err == nil && r.firstReady.readyForFirstTime(req.NamespacedName, managed) {
+			r.metrics.RecordFirstTimeReady(r.gvk, managed)
+		}
+	}()
+
+	if r.trackMutations {
+		var mutated func() []string
+		managed, mutated = TrackMutations(managed)
+		defer func() { log.Debug("Reconciled managed resource", "mutated-paths", mutated()) }()
+	}
+
 	// If managed resource has a deletion timestamp and and a deletion policy of
 	// Orphan, we do not need to observe the external resource before attempting
-	// to unpublish connection details and remove finalizer.
-	if meta.WasDeleted(managed) && managed.GetDeletionPolicy() == xpv1.DeletionOrphan {
+	// to unpublish connection details and remove finalizer. The same is true
+	// when we only observe external resources, since we'll never delete them.
+	if meta.WasDeleted(managed) && (managed.GetDeletionPolicy() == xpv1.DeletionOrphan || r.observeOnly) {
 		log = log.WithValues("deletion-timestamp", managed.GetDeletionTimestamp())
+		audit.Reason = auditReasonNotDeleted
 
 		// Empty ConnectionDetails are passed to UnpublishConnection because we
 		// have not retrieved them from the external resource. In practice we
@@ -680,7 +1118,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			// backoff.
 			log.Debug("Cannot unpublish connection details", "error", err)
 			record.Event(managed, event.Warning(reasonCannotUnpublish, err))
-			managed.SetConditions(xpv1.Deleting(), xpv1.ReconcileError(err))
+			r.conditions.MarkConditions(managed, xpv1.Deleting(), xpv1.ReconcileError(err))
 			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 		if err := r.managed.RemoveFinalizer(ctx, managed); err != nil {
@@ -689,7 +1127,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			// condition. If not, we requeue explicitly, which will trigger
 			// backoff.
 			log.Debug("Cannot remove managed resource finalizer", "error", err)
-			managed.SetConditions(xpv1.Deleting(), xpv1.ReconcileError(err))
+			r.conditions.MarkConditions(managed, xpv1.Deleting(), xpv1.ReconcileError(err))
 			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 
@@ -707,7 +1145,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		// not, we requeue explicitly, which will trigger backoff.
 		log.Debug("Cannot initialize managed resource", "error", err)
 		record.Event(managed, event.Warning(reasonCannotInitialize, err))
-		managed.SetConditions(xpv1.ReconcileError(err))
+		r.conditions.MarkConditions(managed, xpv1.ReconcileError(err))
 		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
@@ -718,7 +1156,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	if meta.ExternalCreateIncomplete(managed) {
 		log.Debug(errCreateIncomplete)
 		record.Event(managed, event.Warning(reasonCannotInitialize, errors.New(errCreateIncomplete)))
-		managed.SetConditions(xpv1.Creating(), xpv1.ReconcileError(errors.New(errCreateIncomplete)))
+		r.conditions.MarkConditions(managed, xpv1.Creating(), xpv1.ReconcileError(errors.New(errCreateIncomplete)))
 		return reconcile.Result{Requeue: false}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
@@ -740,7 +1178,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			// requeue explicitly, which will trigger backoff.
 			log.Debug("Cannot resolve managed resource references", "error", err)
 			record.Event(managed, event.Warning(reasonCannotResolveRefs, err))
-			managed.SetConditions(xpv1.ReconcileError(err))
+			if reference.IsPending(err) {
+				r.conditions.MarkConditions(managed, xpv1.ReferencesPending(err))
+				return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			}
+			r.conditions.MarkConditions(managed, xpv1.ReconcileError(err))
 			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 	}
@@ -754,9 +1196,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		// backoff.
 		log.Debug("Cannot connect to provider", "error", err)
 		record.Event(managed, event.Warning(reasonCannotConnect, err))
-		managed.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errReconcileConnect)))
+		r.conditions.MarkConditions(managed, externalError(externalCtx, errors.Wrap(err, errReconcileConnect)))
 		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
+	if r.panicRecovery {
+		external = &panicRecoveringExternalClient{client: external, record: record}
+	}
 	defer func() {
 		if err := r.external.Disconnect(ctx); err != nil {
 			log.Debug("Cannot disconnect from provider", "error", err)
@@ -774,7 +1219,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		// trigger backoff.
 		log.Debug("Cannot observe external resource", "error", err)
 		record.Event(managed, event.Warning(reasonCannotObserve, err))
-		managed.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errReconcileObserve)))
+		r.conditions.MarkConditions(managed, externalError(externalCtx, errors.Wrap(err, errReconcileObserve)))
 		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
@@ -786,7 +1231,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	if !observation.ResourceExists && meta.ExternalCreateSucceededDuring(managed, r.creationGracePeriod) {
 		log.Debug("Waiting for external resource existence to be confirmed")
 		record.Event(managed, event.Normal(reasonPending, "Waiting for external resource existence to be confirmed"))
-		return reconcile.Result{Requeue: true}, nil
+		r.conditions.MarkConditions(managed, xpv1.ExternalPending())
+		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
 	if meta.WasDeleted(managed) {
@@ -794,7 +1240,33 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 		// We'll only reach this point if deletion policy is not orphan, so we
 		// are safe to call external deletion if external resource exists.
+		if observation.ResourceExists && r.preDelete != nil {
+			if err := r.preDelete(externalCtx, managed); err != nil {
+				// We'll hit this condition if we can't prepare our
+				// external resource for deletion, for example by
+				// disabling its deletion protection. We don't attempt to
+				// delete it until preparation succeeds.
+				log.Debug("Cannot prepare external resource for deletion", "error", err)
+				record.Event(managed, event.Warning(reasonCannotDelete, err))
+				r.conditions.MarkConditions(managed, xpv1.Deleting(), xpv1.ReconcileError(errors.Wrap(err, errReconcilePreDelete)))
+				return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			}
+		}
+		if observation.ResourceExists && r.observeBeforeDelete {
+			// Observe the external resource again immediately before we
+			// delete it, in case it was deleted since we last observed it.
+			// If it no longer exists we'll skip straight to unpublishing
+			// and finalizing.
+			if observation, err = external.Observe(externalCtx, managed); err != nil {
+				log.Debug("Cannot observe external resource", "error", err)
+				record.Event(managed, event.Warning(reasonCannotObserve, err))
+				r.conditions.MarkConditions(managed, xpv1.Deleting(), externalError(externalCtx, errors.Wrap(err, errReconcileObserve)))
+				return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			}
+		}
+		audit.Reason = auditReasonDeleted
 		if observation.ResourceExists {
+			audit.Action = AuditActionDelete
 			if err := external.Delete(externalCtx, managed); err != nil {
 				// We'll hit this condition if we can't delete our external
 				// resource, for example if our provider credentials don't have
@@ -804,7 +1276,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 				// explicitly, which will trigger backoff.
 				log.Debug("Cannot delete external resource", "error", err)
 				record.Event(managed, event.Warning(reasonCannotDelete, err))
-				managed.SetConditions(xpv1.Deleting(), xpv1.ReconcileError(errors.Wrap(err, errReconcileDelete)))
+				r.conditions.MarkConditions(managed, xpv1.Deleting(), externalError(externalCtx, errors.Wrap(err, errReconcileDelete)))
 				return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 			}
 
@@ -817,7 +1289,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			// block and try again.
 			log.Debug("Successfully requested deletion of external resource")
 			record.Event(managed, event.Normal(reasonDeleted, "Successfully requested deletion of external resource"))
-			managed.SetConditions(xpv1.Deleting(), xpv1.ReconcileSuccess())
+			r.conditions.MarkConditions(managed, xpv1.Deleting(), xpv1.ReconcileSuccess())
 			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 		if err := r.managed.UnpublishConnection(ctx, managed, observation.ConnectionDetails); err != nil {
@@ -827,7 +1299,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			// backoff.
 			log.Debug("Cannot unpublish connection details", "error", err)
 			record.Event(managed, event.Warning(reasonCannotUnpublish, err))
-			managed.SetConditions(xpv1.Deleting(), xpv1.ReconcileError(err))
+			r.conditions.MarkConditions(managed, xpv1.Deleting(), xpv1.ReconcileError(err))
 			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 		if err := r.managed.RemoveFinalizer(ctx, managed); err != nil {
@@ -836,7 +1308,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			// condition. If not, we requeue explicitly, which will trigger
 			// backoff.
 			log.Debug("Cannot remove managed resource finalizer", "error", err)
-			managed.SetConditions(xpv1.Deleting(), xpv1.ReconcileError(err))
+			r.conditions.MarkConditions(managed, xpv1.Deleting(), xpv1.ReconcileError(err))
 			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 
@@ -848,13 +1320,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{Requeue: false}, nil
 	}
 
-	if _, err := r.managed.PublishConnection(ctx, managed, observation.ConnectionDetails); err != nil {
+	if _, err := r.publishConnection(ctx, managed, observation.ConnectionDetails); err != nil {
 		// If this is the first time we encounter this issue we'll be requeued
 		// implicitly when we update our status with the new error condition. If
 		// not, we requeue explicitly, which will trigger backoff.
 		log.Debug("Cannot publish connection details", "error", err)
 		record.Event(managed, event.Warning(reasonCannotPublish, err))
-		managed.SetConditions(xpv1.ReconcileError(err))
+		r.conditions.MarkConditions(managed, xpv1.ReconcileError(err))
 		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
@@ -863,11 +1335,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		// implicitly when we update our status with the new error condition. If
 		// not, we requeue explicitly, which will trigger backoff.
 		log.Debug("Cannot add finalizer", "error", err)
-		managed.SetConditions(xpv1.ReconcileError(err))
+		r.conditions.MarkConditions(managed, xpv1.ReconcileError(err))
 		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
-	if !observation.ResourceExists {
+	if !observation.ResourceExists && !r.observeOnly {
 		// We write this annotation for two reasons. Firstly, it helps
 		// us to detect the case in which we fail to persist critical
 		// information (like the external name) that may be set by the
@@ -879,10 +1351,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		if err := r.client.Update(ctx, managed); err != nil {
 			log.Debug(errUpdateManaged, "error", err)
 			record.Event(managed, event.Warning(reasonCannotUpdateManaged, errors.Wrap(err, errUpdateManaged)))
-			managed.SetConditions(xpv1.Creating(), xpv1.ReconcileError(errors.Wrap(err, errUpdateManaged)))
+			r.conditions.MarkConditions(managed, xpv1.Creating(), xpv1.ReconcileError(errors.Wrap(err, errUpdateManaged)))
 			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 
+		audit.Action, audit.Reason = AuditActionCreate, auditReasonNotExist
 		creation, err := external.Create(externalCtx, managed)
 		if err != nil {
 			// We'll hit this condition if we can't create our external
@@ -911,7 +1384,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 				// create failed.
 			}
 
-			managed.SetConditions(xpv1.Creating(), xpv1.ReconcileError(errors.Wrap(err, errReconcileCreate)))
+			r.conditions.MarkConditions(managed, xpv1.Creating(), externalError(externalCtx, errors.Wrap(err, errReconcileCreate)))
 			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 
@@ -929,21 +1402,22 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		// reverted when annotations are updated; at the time of writing
 		// Create implementations are advised not to alter status, but
 		// we may revisit this in future.
+		r.writeExternalName(managed)
 		meta.SetExternalCreateSucceeded(managed, time.Now())
 		if err := r.managed.UpdateCriticalAnnotations(ctx, managed); err != nil {
 			log.Debug(errUpdateManagedAnnotations, "error", err)
 			record.Event(managed, event.Warning(reasonCannotUpdateManaged, errors.Wrap(err, errUpdateManagedAnnotations)))
-			managed.SetConditions(xpv1.Creating(), xpv1.ReconcileError(errors.Wrap(err, errUpdateManagedAnnotations)))
+			r.conditions.MarkConditions(managed, xpv1.Creating(), xpv1.CannotSaveExternalName(errors.Wrap(err, errUpdateManagedAnnotations)))
 			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 
-		if _, err := r.managed.PublishConnection(ctx, managed, creation.ConnectionDetails); err != nil {
+		if _, err := r.publishConnection(ctx, managed, MergeConnectionDetails(observation.ConnectionDetails, creation.ConnectionDetails)); err != nil {
 			// If this is the first time we encounter this issue we'll be
 			// requeued implicitly when we update our status with the new error
 			// condition. If not, we requeue explicitly, which will trigger backoff.
 			log.Debug("Cannot publish connection details", "error", err)
 			record.Event(managed, event.Warning(reasonCannotPublish, err))
-			managed.SetConditions(xpv1.Creating(), xpv1.ReconcileError(err))
+			r.conditions.MarkConditions(managed, xpv1.Creating(), xpv1.ReconcileError(err))
 			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 
@@ -953,11 +1427,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		// ready for use.
 		log.Debug("Successfully requested creation of external resource")
 		record.Event(managed, event.Normal(reasonCreated, "Successfully requested creation of external resource"))
-		managed.SetConditions(xpv1.Creating(), xpv1.ReconcileSuccess())
+		r.conditions.MarkConditions(managed, xpv1.Creating(), xpv1.ReconcileSuccess())
 		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
-	if observation.ResourceLateInitialized {
+	if observation.ResourceLateInitialized && r.lateInitDisabled {
+		// The late initialized spec is not persisted, but it is what Update
+		// sees below. See WithLateInitializationDisabled.
+		log.Debug("Not persisting late initialized spec because late initialization is disabled")
+	} else if observation.ResourceLateInitialized {
 		// Note that this update may reset any pending updates to the status of
 		// the managed resource from when it was observed above. This is because
 		// the API server replies to the update with its unchanged view of the
@@ -968,12 +1446,37 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		if err := r.client.Update(ctx, managed); err != nil {
 			log.Debug(errUpdateManaged, "error", err)
 			record.Event(managed, event.Warning(reasonCannotUpdateManaged, err))
-			managed.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errUpdateManaged)))
+			r.conditions.MarkConditions(managed, xpv1.ReconcileError(errors.Wrap(err, errUpdateManaged)))
 			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 	}
 
+	if r.observeOnly {
+		audit.Reason = auditReasonObserveOnly
+
+		// We never create, update, or delete our external resource when we
+		// only observe it. We report whether it differs from the desired
+		// state and requeue a speculative reconcile after the poll interval
+		// in order to observe it again.
+		uptodate := xpv1.UpToDate()
+		switch {
+		case !observation.ResourceExists:
+			uptodate = xpv1.Drifted(errExternalResourceNotExist)
+		case !observation.ResourceUpToDate && len(observation.DriftDetails) > 0:
+			uptodate = xpv1.Drifted(strings.Join(observation.DriftDetails, "; "))
+		case !observation.ResourceUpToDate:
+			// The diff is intended for debugging and may include sensitive
+			// data, so we don't include it in our condition.
+			uptodate = xpv1.Drifted(errExternalResourceDrifted)
+		}
+		log.Debug("Observed external resource", "up-to-date", uptodate.Status, "requeue-after", time.Now().Add(r.pollInterval))
+		r.conditions.MarkConditions(managed, xpv1.ObserveOnly(), uptodate)
+		return reconcile.Result{RequeueAfter: r.pollInterval}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+	}
+
 	if observation.ResourceUpToDate {
+		audit.Reason = auditReasonUpToDate
+
 		// We did not need to create, update, or delete our external resource.
 		// Per the below issue nothing will notify us if and when the external
 		// resource we manage changes, so we requeue a speculative reconcile
@@ -981,14 +1484,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		// accordingly.
 		// https://github.com/crossplane/crossplane/issues/289
 		log.Debug("External resource is up to date", "requeue-after", time.Now().Add(r.pollInterval))
-		managed.SetConditions(xpv1.ReconcileSuccess())
+		r.conditions.MarkConditions(managed, xpv1.ReconcileSuccess())
 		return reconcile.Result{RequeueAfter: r.pollInterval}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
 	if observation.Diff != "" {
 		log.Debug("External resource differs from desired state", "diff", observation.Diff)
 	}
+	if len(observation.DriftDetails) > 0 {
+		record.Event(managed, event.Normal(reasonDrifted, "External resource differs from desired state: "+strings.Join(observation.DriftDetails, "; ")))
+	}
 
+	audit.Action, audit.Reason = AuditActionUpdate, auditReasonNotUpToDate
 	update, err := external.Update(externalCtx, managed)
 	if err != nil {
 		// We'll hit this condition if we can't update our external resource,
@@ -998,17 +1505,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		// condition. If not, we requeue explicitly, which will trigger backoff.
 		log.Debug("Cannot update external resource")
 		record.Event(managed, event.Warning(reasonCannotUpdate, err))
-		managed.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errReconcileUpdate)))
+		r.conditions.MarkConditions(managed, externalError(externalCtx, errors.Wrap(err, errReconcileUpdate)))
 		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
-	if _, err := r.managed.PublishConnection(ctx, managed, update.ConnectionDetails); err != nil {
+	if _, err := r.publishConnection(ctx, managed, MergeConnectionDetails(observation.ConnectionDetails, update.ConnectionDetails)); err != nil {
 		// If this is the first time we encounter this issue we'll be requeued
 		// implicitly when we update our status with the new error condition. If
 		// not, we requeue explicitly, which will trigger backoff.
 		log.Debug("Cannot publish connection details", "error", err)
 		record.Event(managed, event.Warning(reasonCannotPublish, err))
-		managed.SetConditions(xpv1.ReconcileError(err))
+		r.conditions.MarkConditions(managed, xpv1.ReconcileError(err))
 		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
@@ -1019,6 +1526,42 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	// https://github.com/crossplane/crossplane/issues/289
 	log.Debug("Successfully requested update of external resource", "requeue-after", time.Now().Add(r.pollInterval))
 	record.Event(managed, event.Normal(reasonUpdated, "Successfully requested update of external resource"))
-	managed.SetConditions(xpv1.ReconcileSuccess())
+	r.conditions.MarkConditions(managed, xpv1.ReconcileSuccess())
 	return reconcile.Result{RequeueAfter: r.pollInterval}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 }
+
+// reconcileFailed returns true if the supplied managed resource's Synced
+// condition indicates that it could not be reconciled.
+func reconcileFailed(mg resource.Managed) bool {
+	switch mg.GetCondition(xpv1.TypeSynced).Reason {
+	case xpv1.ReasonReconcileError, xpv1.ReasonReconcileTimeout, xpv1.ReasonCannotSaveExternalName, xpv1.ReasonPanicRecovered:
+		return true
+	}
+	return false
+}
+
+// An errorCounter tracks how many consecutive times reconciliation of each
+// managed resource has failed.
+type errorCounter struct {
+	mx     sync.Mutex
+	counts map[types.NamespacedName]int
+}
+
+func (c *errorCounter) inc(n types.NamespacedName) int {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.counts[n]++
+	return c.counts[n]
+}
+
+func (c *errorCounter) get(n types.NamespacedName) int {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	return c.counts[n]
+}
+
+func (c *errorCounter) reset(n types.NamespacedName) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	delete(c.counts, n)
+}
@@ -18,11 +18,14 @@ package managed
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/google/go-cmp/cmp"
@@ -32,8 +35,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/conditions"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
@@ -54,6 +60,7 @@ func TestReconciler(t *testing.T) {
 	}
 
 	errBoom := errors.New("boom")
+	errPending := errors.Wrap(reference.ResolutionResponse{}.Validate(), "spec.forProvider.networkId")
 	now := metav1.Now()
 
 	cases := map[string]struct {
@@ -258,6 +265,34 @@ func TestReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{Requeue: true}},
 		},
+		"ResolveReferencesPending": {
+			reason: "References that are not yet ready should trigger a requeue after a short wait.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil),
+						MockStatusUpdate: test.MockStatusUpdateFn(func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+							want := &fake.Managed{}
+							want.SetConditions(xpv1.ReferencesPending(errPending))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "References that are not yet ready should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, res resource.Managed) error {
+						return errPending
+					})),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
 		"ExternalConnectError": {
 			reason: "Errors connecting to the provider should trigger a requeue after a short wait.",
 			args: args{
@@ -358,13 +393,62 @@ func TestReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{Requeue: true}},
 		},
+		"ExternalObserveTimeout": {
+			reason: "Observing the external resource for longer than the timeout annotation allows should be reported as a timeout.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							meta.AddAnnotations(obj, map[string]string{meta.AnnotationKeyReconcileTimeout: "1ms"})
+							return nil
+						}),
+						MockStatusUpdate: test.MockStatusUpdateFn(func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+							want := &fake.Managed{}
+							meta.AddAnnotations(want, map[string]string{meta.AnnotationKeyReconcileTimeout: "1ms"})
+							want.SetConditions(xpv1.ReconcileTimeout(errors.Wrap(context.DeadlineExceeded, errReconcileObserve)))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "Timeouts observing the managed resource should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithMaxReconcileTimeout(time.Minute),
+					WithInitializers(),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, mg resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(ctx context.Context, _ resource.Managed) (ExternalObservation, error) {
+								<-ctx.Done()
+								return ExternalObservation{}, ctx.Err()
+							},
+						}
+						return c, nil
+					})),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
 		"CreationGracePeriod": {
 			reason: "If our resource appears not to exist during the creation grace period we should return early.",
 			args: args{
 				m: &fake.Manager{
 					Client: &test.MockClient{
 						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
-							meta.SetExternalCreateSucceeded(obj, time.Now())
+							meta.SetExternalCreateSucceeded(obj, now.Time)
+							return nil
+						}),
+						MockStatusUpdate: test.MockStatusUpdateFn(func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+							want := &fake.Managed{}
+							meta.SetExternalCreateSucceeded(want, now.Time)
+							want.SetConditions(xpv1.ExternalPending())
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "Waiting for the external resource to exist should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
 							return nil
 						}),
 					},
@@ -756,7 +840,7 @@ func TestReconciler(t *testing.T) {
 							want := &fake.Managed{}
 							meta.SetExternalCreatePending(want, time.Now())
 							meta.SetExternalCreateSucceeded(want, time.Now())
-							want.SetConditions(xpv1.ReconcileError(errors.Wrap(errBoom, errUpdateManagedAnnotations)))
+							want.SetConditions(xpv1.CannotSaveExternalName(errors.Wrap(errBoom, errUpdateManagedAnnotations)))
 							want.SetConditions(xpv1.Creating())
 							if diff := cmp.Diff(want, obj, test.EquateConditions(), cmpopts.EquateApproxTime(1*time.Second)); diff != "" {
 								reason := "Errors updating critical annotations after creation should be reported as a conditioned status."
@@ -877,6 +961,81 @@ func TestReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{Requeue: true}},
 		},
+		"ExternalNameAnnotationRead": {
+			reason: "The external name should be read from the supplied annotation if the standard annotation is not set.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							obj.SetAnnotations(map[string]string{"example.org/name": "cool"})
+							return nil
+						}),
+						MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithExternalNameAnnotation("example.org/name"),
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, mg resource.Managed) (ExternalObservation, error) {
+								if diff := cmp.Diff("cool", meta.GetExternalName(mg)); diff != "" {
+									t.Errorf("\nReason: the external name should be read from the supplied annotation\n-want, +got:\n%s", diff)
+								}
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+							},
+						}
+						return c, nil
+					})),
+					WithConnectionPublishers(),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: defaultpollInterval}},
+		},
+		"ExternalNameAnnotationWrite": {
+			reason: "An external name set by Create should be persisted under both the standard and the supplied annotation.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:          test.NewMockGetFn(nil),
+						MockUpdate:       test.NewMockUpdateFn(nil),
+						MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithExternalNameAnnotation("example.org/name"),
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: false}, nil
+							},
+							CreateFn: func(_ context.Context, mg resource.Managed) (ExternalCreation, error) {
+								meta.SetExternalName(mg, "cool")
+								return ExternalCreation{}, nil
+							},
+						}
+						return c, nil
+					})),
+					WithCriticalAnnotationUpdater(CriticalAnnotationUpdateFn(func(ctx context.Context, o client.Object) error {
+						if diff := cmp.Diff("cool", o.GetAnnotations()["example.org/name"]); diff != "" {
+							t.Errorf("\nReason: the external name should be written under the supplied annotation\n-want, +got:\n%s", diff)
+						}
+						return nil
+					})),
+					WithConnectionPublishers(),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
 		"LateInitializeUpdateError": {
 			reason: "Errors updating a managed resource to persist late initialized fields should trigger a requeue after a short wait.",
 			args: args{
@@ -914,6 +1073,42 @@ func TestReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{Requeue: true}},
 		},
+		"LateInitializationDisabled": {
+			reason: "Late initialized fields should not be persisted when late initialization is disabled.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil),
+						MockUpdate: test.MockUpdateFn(func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+							t.Errorf("Update should not be called when late initialization is disabled")
+							return nil
+						}),
+						MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithLateInitializationDisabled(),
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, mg resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(ctx context.Context, _ resource.Managed) (ExternalObservation, error) {
+								if i, _ := InfoFromContext(ctx); !i.LateInitializationDisabled {
+									t.Errorf("ReconcileInfo should indicate that late initialization is disabled")
+								}
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}, nil
+							},
+						}
+						return c, nil
+					})),
+					WithConnectionPublishers(),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: defaultpollInterval}},
+		},
 		"ExternalResourceUpToDate": {
 			reason: "When the external resource exists and is up to date a requeue should be triggered after a long wait.",
 			args: args{
@@ -1078,19 +1273,1321 @@ func TestReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{RequeueAfter: defaultpollInterval}},
 		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			r := NewReconciler(tc.args.m, tc.args.mg, tc.args.o...)
-			got, err := r.Reconcile(context.Background(), reconcile.Request{})
-
-			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("\nReason: %s\nr.Reconcile(...): -want error, +got error:\n%s", tc.reason, diff)
-			}
-
-			if diff := cmp.Diff(tc.want.result, got); diff != "" {
-				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+		"ObserveOnlyDeleted": {
+			reason: "A deleted managed resource should be finalized without deleting the external resource when observing only.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := obj.(*fake.Managed)
+							mg.SetDeletionTimestamp(&now)
+							mg.SetDeletionPolicy(xpv1.DeletionDelete)
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithObserveOnly(),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, mg resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true}, nil
+							},
+							DeleteFn: func(_ context.Context, _ resource.Managed) error {
+								t.Errorf("Delete should not be called when observing only")
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithConnectionPublishers(),
+					WithFinalizer(resource.FinalizerFns{RemoveFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: false}},
+		},
+		"ObserveOnlyExternalResourceDoesNotExist": {
+			reason: "An external resource that does not exist should not be created when observing only.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil),
+						MockStatusUpdate: test.MockStatusUpdateFn(func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+							want := &fake.Managed{}
+							want.SetConditions(xpv1.ObserveOnly(), xpv1.Drifted(errExternalResourceNotExist))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "A missing external resource should be reported as drift when observing only."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithObserveOnly(),
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, mg resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: false}, nil
+							},
+							CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+								t.Errorf("Create should not be called when observing only")
+								return ExternalCreation{}, nil
+							},
+						}
+						return c, nil
+					})),
+					WithConnectionPublishers(),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: defaultpollInterval}},
+		},
+		"ObserveOnlyExternalResourceDriftDetails": {
+			reason: "Drift details should be reported in the UpToDate condition when observing only.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil),
+						MockStatusUpdate: test.MockStatusUpdateFn(func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+							want := &fake.Managed{}
+							want.SetConditions(xpv1.ObserveOnly(), xpv1.Drifted("spec.size; spec.tags"))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "Drift details should be reported as the drift message when observing only."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithObserveOnly(),
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, mg resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: false, Diff: "-want, +got", DriftDetails: []string{"spec.size", "spec.tags"}}, nil
+							},
+						}
+						return c, nil
+					})),
+					WithConnectionPublishers(),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: defaultpollInterval}},
+		},
+		"ObserveOnlyExternalResourceDrifted": {
+			reason: "An external resource that is not up to date should not be updated when observing only.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil),
+						MockStatusUpdate: test.MockStatusUpdateFn(func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+							want := &fake.Managed{}
+							want.SetConditions(xpv1.ObserveOnly(), xpv1.Drifted(errExternalResourceDrifted))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "An external resource that is not up to date should be reported as drift when observing only."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithObserveOnly(),
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, mg resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: false, Diff: "-want, +got"}, nil
+							},
+							UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+								t.Errorf("Update should not be called when observing only")
+								return ExternalUpdate{}, nil
+							},
+						}
+						return c, nil
+					})),
+					WithConnectionPublishers(),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: defaultpollInterval}},
+		},
+		"ObservePanicRecovered": {
+			reason: "Panics while observing the external resource should be recovered and trigger a requeue when panic recovery is enabled.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil),
+						MockStatusUpdate: test.MockStatusUpdateFn(func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+							want := &fake.Managed{}
+							want.SetConditions(xpv1.PanicRecovered(errors.Wrap(&panicError{value: "boom"}, errReconcileObserve)))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "Recovered panics should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, mg resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								panic("boom")
+							},
+						}
+						return c, nil
+					})),
+					WithPanicRecovery(),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
+		"PreDeleteError": {
+			reason: "Errors preparing the external resource for deletion should trigger a requeue without deleting it.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := obj.(*fake.Managed)
+							mg.SetDeletionTimestamp(&now)
+							mg.SetDeletionPolicy(xpv1.DeletionDelete)
+							return nil
+						}),
+						MockStatusUpdate: test.MockStatusUpdateFn(func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+							want := &fake.Managed{}
+							want.SetDeletionTimestamp(&now)
+							want.SetDeletionPolicy(xpv1.DeletionDelete)
+							want.SetConditions(xpv1.ReconcileError(errors.Wrap(errBoom, errReconcilePreDelete)))
+							want.SetConditions(xpv1.Deleting())
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "An error preparing an external resource for deletion should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, mg resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true}, nil
+							},
+							DeleteFn: func(_ context.Context, _ resource.Managed) error {
+								t.Errorf("Delete should not be called when pre-delete fails")
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithPreDelete(func(_ context.Context, _ resource.Managed) error { return errBoom }),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
+		"ReconciliationPaused": {
+			reason: "A paused managed resource should not be reconciled, even if it was deleted.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := obj.(*fake.Managed)
+							mg.SetAnnotations(map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+							mg.SetDeletionTimestamp(&now)
+							return nil
+						}),
+						MockStatusUpdate: test.MockStatusUpdateFn(func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+							want := &fake.Managed{}
+							want.SetAnnotations(map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+							want.SetDeletionTimestamp(&now)
+							want.SetConditions(xpv1.ReconcilePaused())
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "Paused reconciliation should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithPauseAnnotation(),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						t.Errorf("Connect should not be called when reconciliation is paused")
+						return nil, nil
+					})),
+				},
+			},
+			want: want{result: reconcile.Result{}},
+		},
+		"ReconciliationPausedWithoutStatusUpdates": {
+			reason: "A paused managed resource's status should not be updated if status updates are suppressed.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := obj.(*fake.Managed)
+							mg.SetAnnotations(map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+							return nil
+						}),
+						MockStatusUpdate: test.MockStatusUpdateFn(func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+							t.Errorf("Status should not be updated when reconciliation is paused and status updates are suppressed")
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithoutPausedStatusUpdates(),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						t.Errorf("Connect should not be called when reconciliation is paused")
+						return nil, nil
+					})),
+				},
+			},
+			want: want{result: reconcile.Result{}},
+		},
+		"ObserveBeforeDeleteResourceGone": {
+			reason: "The external resource should not be deleted if it no longer exists when observed immediately before deletion.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := obj.(*fake.Managed)
+							mg.SetDeletionTimestamp(&now)
+							mg.SetDeletionPolicy(xpv1.DeletionDelete)
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, mg resource.Managed) (ExternalClient, error) {
+						observed := false
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								// The resource exists when first observed, but
+								// is gone by the time it's observed again.
+								exists := !observed
+								observed = true
+								return ExternalObservation{ResourceExists: exists}, nil
+							},
+							DeleteFn: func(_ context.Context, _ resource.Managed) error {
+								t.Errorf("Delete should not be called when the external resource no longer exists")
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithConnectionPublishers(),
+					WithFinalizer(resource.FinalizerFns{RemoveFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+					WithObserveBeforeDelete(),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: false}},
+		},
+		"TransformConnectionDetailsError": {
+			reason: "Errors transforming connection details should trigger a requeue without publishing them.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil),
+						MockStatusUpdate: test.MockStatusUpdateFn(func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+							want := &fake.Managed{}
+							want.SetConditions(xpv1.ReconcileError(errors.Wrap(errBoom, errReconcileTransform)))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "Errors transforming connection details should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(&NopConnecter{}),
+					WithConnectionPublishers(ConnectionPublisherFns{
+						PublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, _ ConnectionDetails) (bool, error) {
+							t.Errorf("PublishConnection should not be called when transforming connection details fails")
+							return false, nil
+						},
+					}),
+					WithConnectionTransformer(func(_ context.Context, _ resource.Managed, _ ConnectionDetails) (ConnectionDetails, error) {
+						return nil, errBoom
+					}),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
+		"TransformConnectionDetails": {
+			reason: "Transformed connection details should be published.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:          test.NewMockGetFn(nil),
+						MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, mg resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: ConnectionDetails{"a": []byte("b")}}, nil
+							},
+						}
+						return c, nil
+					})),
+					WithConnectionPublishers(ConnectionPublisherFns{
+						PublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, cd ConnectionDetails) (bool, error) {
+							want := ConnectionDetails{"a": []byte("b"), "derived": []byte("b")}
+							if diff := cmp.Diff(want, cd); diff != "" {
+								t.Errorf("\nReason: Transformed connection details should be published.\n-want, +got:\n%s", diff)
+							}
+							return true, nil
+						},
+					}),
+					WithConnectionTransformer(func(_ context.Context, _ resource.Managed, in ConnectionDetails) (ConnectionDetails, error) {
+						out := ConnectionDetails{"derived": in["a"]}
+						for k, v := range in {
+							out[k] = v
+						}
+						return out, nil
+					}),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: defaultpollInterval}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewReconciler(tc.args.m, tc.args.mg, tc.args.o...)
+			got, err := r.Reconcile(context.Background(), reconcile.Request{})
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerFailureBackoff(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type step string
+	const (
+		stepConnectError step = "ConnectError"
+		stepStatusError  step = "StatusError"
+		stepCreate       step = "Create"
+		stepUpToDate     step = "UpToDate"
+	)
+
+	// Each step configures how the reconcile behaves.
+	current := stepConnectError
+
+	m := &fake.Manager{
+		Client: &test.MockClient{
+			MockGet:    test.NewMockGetFn(nil),
+			MockUpdate: test.NewMockUpdateFn(nil),
+			MockStatusUpdate: test.MockStatusUpdateFn(func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+				if current == stepStatusError {
+					return errBoom
+				}
+				return nil
+			}),
+		},
+		Scheme: fake.SchemeWith(&fake.Managed{}),
+	}
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, mg resource.Managed) (ExternalClient, error) {
+			if current == stepConnectError || current == stepStatusError {
+				return nil, errBoom
+			}
+			c := &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return ExternalObservation{ResourceExists: current != stepCreate, ResourceUpToDate: true}, nil
+				},
+				CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+					return ExternalCreation{}, nil
+				},
+			}
+			return c, nil
+		})),
+		WithCriticalAnnotationUpdater(CriticalAnnotationUpdateFn(func(_ context.Context, _ client.Object) error { return nil })),
+		WithConnectionPublishers(),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+		WithFailureBackoff(func(errorCount int) time.Duration { return time.Duration(errorCount) * time.Second }),
+	)
+
+	steps := []struct {
+		reason string
+		step   step
+		want   reconcile.Result
+	}{
+		{
+			reason: "The first failed reconcile should be requeued after the backoff for one error.",
+			step:   stepConnectError,
+			want:   reconcile.Result{RequeueAfter: 1 * time.Second},
+		},
+		{
+			reason: "Consecutive failed reconciles should back off progressively.",
+			step:   stepConnectError,
+			want:   reconcile.Result{RequeueAfter: 2 * time.Second},
+		},
+		{
+			reason: "A reconcile that returns an error should back off without returning the error.",
+			step:   stepStatusError,
+			want:   reconcile.Result{RequeueAfter: 3 * time.Second},
+		},
+		{
+			reason: "A successful create should requeue immediately, and should not be considered a failure.",
+			step:   stepCreate,
+			want:   reconcile.Result{Requeue: true},
+		},
+		{
+			reason: "A failed reconcile following a successful create should use the backoff for one error.",
+			step:   stepConnectError,
+			want:   reconcile.Result{RequeueAfter: 1 * time.Second},
+		},
+		{
+			reason: "A successful reconcile should requeue after the poll interval.",
+			step:   stepUpToDate,
+			want:   reconcile.Result{RequeueAfter: defaultpollInterval},
+		},
+		{
+			reason: "A failed reconcile following a successful reconcile should use the backoff for one error.",
+			step:   stepConnectError,
+			want:   reconcile.Result{RequeueAfter: 1 * time.Second},
+		},
+	}
+
+	for i, s := range steps {
+		current = s.step
+		got, err := r.Reconcile(context.Background(), reconcile.Request{})
+		if err != nil {
+			t.Fatalf("\nStep %d: %s\nr.Reconcile(...): unexpected error: %s", i, s.reason, err)
+		}
+		if diff := cmp.Diff(s.want, got); diff != "" {
+			t.Errorf("\nStep %d: %s\nr.Reconcile(...): -want, +got:\n%s", i, s.reason, diff)
+		}
+	}
+}
+
+func TestManagedGVKs(t *testing.T) {
+	s := fake.SchemeWith(&fake.Managed{}, &fake.Object{})
+	want := []schema.GroupVersionKind{fake.GVK(&fake.Managed{})}
+	if diff := cmp.Diff(want, ManagedGVKs(s)); diff != "" {
+		t.Errorf("ManagedGVKs(...): only kinds that implement resource.Managed should be returned: -want, +got:\n%s", diff)
+	}
+}
+
+func TestReconcilerInfo(t *testing.T) {
+	errBoom := errors.New("boom")
+	uid := types.UID("cool-uid")
+
+	got := make([]ReconcileInfo, 0)
+	m := &fake.Manager{
+		Client: &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				mg := obj.(*fake.Managed)
+				mg.SetUID(uid)
+				mg.SetProviderConfigReference(&xpv1.Reference{Name: "cool-config"})
+				return nil
+			}),
+			MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+		},
+		Scheme: fake.SchemeWith(&fake.Managed{}),
+	}
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, mg resource.Managed) (ExternalClient, error) {
+			c := &ExternalClientFns{
+				ObserveFn: func(ctx context.Context, _ resource.Managed) (ExternalObservation, error) {
+					i, _ := InfoFromContext(ctx)
+					got = append(got, i)
+					return ExternalObservation{}, errBoom
+				},
+			}
+			return c, nil
+		})),
+	)
+
+	for i := 0; i < 2; i++ {
+		if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+			t.Fatalf("r.Reconcile(...): unexpected error: %s", err)
+		}
+	}
+
+	want := []ReconcileInfo{
+		{UID: uid, GVK: fake.GVK(&fake.Managed{}), ProviderConfigName: "cool-config", Attempt: 1},
+		{UID: uid, GVK: fake.GVK(&fake.Managed{}), ProviderConfigName: "cool-config", Attempt: 2},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("InfoFromContext(...): the ExternalClient should be passed reconcile info, counting consecutive attempts: -want, +got:\n%s", diff)
+	}
+}
+
+type recordingMetricRecorder struct {
+	firstTimeReady []schema.GroupVersionKind
+}
+
+func (r *recordingMetricRecorder) RecordFirstTimeReady(gvk schema.GroupVersionKind, _ resource.Managed) {
+	r.firstTimeReady = append(r.firstTimeReady, gvk)
+}
+
+func TestReconcilerFirstTimeReady(t *testing.T) {
+	gvk := fake.GVK(&fake.Managed{})
+
+	cases := map[string]struct {
+		reason   string
+		previous []xpv1.Condition
+		want     []schema.GroupVersionKind
+	}{
+		"NoPreviousCondition": {
+			reason: "A resource with no Ready condition that becomes ready should be recorded.",
+			want:   []schema.GroupVersionKind{gvk},
+		},
+		"PreviouslyCreating": {
+			reason:   "A resource that was being created and becomes ready should be recorded.",
+			previous: []xpv1.Condition{xpv1.Creating()},
+			want:     []schema.GroupVersionKind{gvk},
+		},
+		"PreviouslyUnavailable": {
+			reason:   "A resource that is first observed as unavailable may have been ready before, and should not be recorded.",
+			previous: []xpv1.Condition{xpv1.Unavailable()},
+		},
+		"AlreadyReady": {
+			reason:   "A resource that was already ready should not be recorded.",
+			previous: []xpv1.Condition{xpv1.Available()},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mr := &recordingMetricRecorder{}
+			m := &fake.Manager{
+				Client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						obj.(*fake.Managed).SetConditions(tc.previous...)
+						return nil
+					}),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+				},
+				Scheme: fake.SchemeWith(&fake.Managed{}),
+			}
+			r := NewReconciler(m, resource.ManagedKind(gvk),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					c := &ExternalClientFns{
+						ObserveFn: func(_ context.Context, mg resource.Managed) (ExternalObservation, error) {
+							mg.SetConditions(xpv1.Available())
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+					}
+					return c, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				WithMetricRecorder(mr),
+			)
+
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\n%s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, mr.firstTimeReady); diff != "" {
+				t.Errorf("\n%s\nRecordFirstTimeReady(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerMaxReconcileTimeout(t *testing.T) {
+	type args struct {
+		annotation string
+		o          []ReconcilerOption
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   time.Duration
+	}{
+		"AnnotationIgnored": {
+			reason: "The timeout annotation should be ignored unless a maximum timeout is specified.",
+			args: args{
+				annotation: "1s",
+				o:          []ReconcilerOption{WithTimeout(time.Hour)},
+			},
+			want: time.Hour,
+		},
+		"AnnotationHonoured": {
+			reason: "The timeout annotation should override the timeout if it is below the maximum.",
+			args: args{
+				annotation: "1s",
+				o:          []ReconcilerOption{WithTimeout(time.Hour), WithMaxReconcileTimeout(time.Minute)},
+			},
+			want: time.Second,
+		},
+		"AnnotationClamped": {
+			reason: "The timeout annotation should be reduced to the maximum if it exceeds it.",
+			args: args{
+				annotation: "8760h",
+				o:          []ReconcilerOption{WithTimeout(time.Second), WithMaxReconcileTimeout(time.Minute)},
+			},
+			want: time.Minute,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got time.Duration
+			m := &fake.Manager{
+				Client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						meta.AddAnnotations(obj, map[string]string{meta.AnnotationKeyReconcileTimeout: tc.args.annotation})
+						return nil
+					}),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+				},
+				Scheme: fake.SchemeWith(&fake.Managed{}),
+			}
+			o := append([]ReconcilerOption{
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					c := &ExternalClientFns{
+						ObserveFn: func(ctx context.Context, _ resource.Managed) (ExternalObservation, error) {
+							d, _ := ctx.Deadline()
+							got = time.Until(d)
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+					}
+					return c, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			}, tc.args.o...)
+			r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.Managed{})), o...)
+
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\n%s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			// Allow for the time taken to reach Observe.
+			if got > tc.want || got < tc.want-time.Second {
+				t.Errorf("\n%s\nr.Reconcile(...): want external deadline %s from now, got %s", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestReconcilerFirstTimeReadyTransitions(t *testing.T) {
+	gvk := fake.GVK(&fake.Managed{})
+	errBoom := errors.New("boom")
+
+	// The Ready condition the managed resource was persisted with, which
+	// Observe replaces with the condition of the current step.
+	persisted := []xpv1.Condition{}
+	current := xpv1.Condition{}
+	updateErr := error(nil)
+
+	mr := &recordingMetricRecorder{}
+	m := &fake.Manager{
+		Client: &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				obj.(*fake.Managed).SetConditions(persisted...)
+				return nil
+			}),
+			MockStatusUpdate: test.MockStatusUpdateFn(func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+				if updateErr != nil {
+					return updateErr
+				}
+				persisted = []xpv1.Condition{obj.(*fake.Managed).GetCondition(xpv1.TypeReady)}
+				return nil
+			}),
+		},
+		Scheme: fake.SchemeWith(&fake.Managed{}),
+	}
+	r := NewReconciler(m, resource.ManagedKind(gvk),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			c := &ExternalClientFns{
+				ObserveFn: func(_ context.Context, mg resource.Managed) (ExternalObservation, error) {
+					mg.SetConditions(current)
+					return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+				},
+			}
+			return c, nil
+		})),
+		WithConnectionPublishers(),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+		WithMetricRecorder(mr),
+	)
+
+	steps := []struct {
+		reason    string
+		ready     xpv1.Condition
+		updateErr error
+		want      []schema.GroupVersionKind
+	}{
+		{
+			reason: "A resource that is being created should not be recorded.",
+			ready:  xpv1.Creating(),
+		},
+		{
+			reason: "A resource that became unavailable while being created should not be recorded.",
+			ready:  xpv1.Unavailable(),
+		},
+		{
+			reason:    "A resource whose status cannot be updated to say that it is ready should not be recorded.",
+			ready:     xpv1.Available(),
+			updateErr: errBoom,
+		},
+		{
+			reason: "A resource that becomes ready for the first time after it was created should be recorded.",
+			ready:  xpv1.Available(),
+			want:   []schema.GroupVersionKind{gvk},
+		},
+		{
+			reason: "A resource that becomes unavailable after it was ready should not be recorded.",
+			ready:  xpv1.Unavailable(),
+			want:   []schema.GroupVersionKind{gvk},
+		},
+		{
+			reason: "A resource that becomes ready again should not be recorded again.",
+			ready:  xpv1.Available(),
+			want:   []schema.GroupVersionKind{gvk},
+		},
+	}
+
+	for i, s := range steps {
+		current, updateErr = s.ready, s.updateErr
+		_, _ = r.Reconcile(context.Background(), reconcile.Request{})
+		if diff := cmp.Diff(s.want, mr.firstTimeReady); diff != "" {
+			t.Errorf("\nStep %d: %s\nRecordFirstTimeReady(...): -want, +got:\n%s", i, s.reason, diff)
+		}
+	}
+}
+
+func TestReconcilerConditionManager(t *testing.T) {
+	got := make([]xpv1.Condition, 0)
+	m := &fake.Manager{
+		Client: &test.MockClient{
+			MockGet:          test.NewMockGetFn(nil),
+			MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+		},
+		Scheme: fake.SchemeWith(&fake.Managed{}),
+	}
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			c := &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+				},
+			}
+			return c, nil
+		})),
+		WithConnectionPublishers(),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+		WithConditionManager(conditions.ManagerFn(func(_ resource.Object, c ...xpv1.Condition) {
+			got = append(got, c...)
+		})),
+	)
+
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff([]xpv1.Condition{xpv1.ReconcileSuccess()}, got, test.EquateConditions()); diff != "" {
+		t.Errorf("MarkConditions(...): the Reconciler should mark conditions using the supplied Manager: -want, +got:\n%s", diff)
+	}
+}
+
+func TestReconcilerMaxConditionMessageLength(t *testing.T) {
+	got := make([]xpv1.Condition, 0)
+	m := &fake.Manager{
+		Client: &test.MockClient{
+			MockGet:          test.NewMockGetFn(nil),
+			MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+		},
+		Scheme: fake.SchemeWith(&fake.Managed{}),
+	}
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return nil, errors.New(strings.Repeat("a", 100))
+		})),
+		WithConditionManager(conditions.ManagerFn(func(_ resource.Object, c ...xpv1.Condition) {
+			got = append(got, c...)
+		})),
+		WithMaxConditionMessageLength(32),
+	)
+
+	_, _ = r.Reconcile(context.Background(), reconcile.Request{})
+	want := []xpv1.Condition{xpv1.ReconcileError(errors.New(xpv1.TruncateMessage(errReconcileConnect+": "+strings.Repeat("a", 100), 32)))}
+	if diff := cmp.Diff(want, got, test.EquateConditions()); diff != "" {
+		t.Errorf("MarkConditions(...): the Reconciler should truncate condition messages: -want, +got:\n%s", diff)
+	}
+}
+
+func TestReconcilerObservedGenerationFunc(t *testing.T) {
+	got := make([]xpv1.Condition, 0)
+	m := &fake.Manager{
+		Client: &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				obj.SetGeneration(3)
+				return nil
+			}),
+			MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+		},
+		Scheme: fake.SchemeWith(&fake.Managed{}),
+	}
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return nil, errors.New("boom")
+		})),
+		WithConditionManager(conditions.ManagerFn(func(_ resource.Object, c ...xpv1.Condition) {
+			got = append(got, c...)
+		})),
+		WithObservedGenerationFunc(func(mg resource.Managed) int64 { return mg.GetGeneration() + 39 }),
+	)
+
+	_, _ = r.Reconcile(context.Background(), reconcile.Request{})
+	want := []xpv1.Condition{xpv1.ReconcileError(errors.Wrap(errors.New("boom"), errReconcileConnect)).WithObservedGeneration(42)}
+	if diff := cmp.Diff(want, got, test.EquateConditions()); diff != "" {
+		t.Errorf("MarkConditions(...): the Reconciler should use the supplied observed generation: -want, +got:\n%s", diff)
+	}
+}
+
+func TestReconcilerMergesConnectionDetails(t *testing.T) {
+	got := make([]ConnectionDetails, 0)
+	m := &fake.Manager{
+		Client: &test.MockClient{
+			MockGet:          test.NewMockGetFn(nil),
+			MockUpdate:       test.NewMockUpdateFn(nil),
+			MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+		},
+		Scheme: fake.SchemeWith(&fake.Managed{}),
+	}
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			c := &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return ExternalObservation{ConnectionDetails: ConnectionDetails{"observed": []byte("o"), "shared": []byte("o")}}, nil
+				},
+				CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+					return ExternalCreation{ConnectionDetails: ConnectionDetails{"created": []byte("c"), "shared": []byte("c")}}, nil
+				},
+			}
+			return c, nil
+		})),
+		WithCriticalAnnotationUpdater(CriticalAnnotationUpdateFn(func(_ context.Context, _ client.Object) error { return nil })),
+		WithConnectionPublishers(ConnectionPublisherFns{
+			PublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, cd ConnectionDetails) (bool, error) {
+				got = append(got, cd)
+				return true, nil
+			},
+		}),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+	)
+
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %s", err)
+	}
+	want := []ConnectionDetails{
+		{"observed": []byte("o"), "shared": []byte("o")},
+		{"observed": []byte("o"), "created": []byte("c"), "shared": []byte("c")},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r.Reconcile(...): creation connection details should be merged with observed details: -want, +got:\n%s", diff)
+	}
+}
+
+type recordingRecorder struct {
+	events []event.Event
+}
+
+func (r *recordingRecorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *recordingRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+func TestReconcilerPausedQuietlyEvents(t *testing.T) {
+	rec := &recordingRecorder{}
+	m := &fake.Manager{
+		Client: &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				obj.SetAnnotations(map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+				return nil
+			}),
+		},
+		Scheme: fake.SchemeWith(&fake.Managed{}),
+	}
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithRecorder(rec),
+		WithoutPausedStatusUpdates(),
+	)
+
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff([]event.Event(nil), rec.events); diff != "" {
+		t.Errorf("r.Reconcile(...): no events should be recorded when paused status updates are suppressed: -want, +got:\n%s", diff)
+	}
+}
+
+func TestReconcilerDriftEvent(t *testing.T) {
+	rec := &recordingRecorder{}
+	m := &fake.Manager{
+		Client: &test.MockClient{
+			MockGet:          test.NewMockGetFn(nil),
+			MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+		},
+		Scheme: fake.SchemeWith(&fake.Managed{}),
+	}
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithRecorder(rec),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			c := &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return ExternalObservation{ResourceExists: true, DriftDetails: []string{"spec.size", "spec.tags"}}, nil
+				},
+				UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+					return ExternalUpdate{}, nil
+				},
+			}
+			return c, nil
+		})),
+		WithConnectionPublishers(),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+	)
+
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %s", err)
+	}
+	want := []event.Event{
+		event.Normal(reasonDrifted, "External resource differs from desired state: spec.size; spec.tags"),
+		event.Normal(reasonUpdated, "Successfully requested update of external resource"),
+	}
+	if diff := cmp.Diff(want, rec.events); diff != "" {
+		t.Errorf("r.Reconcile(...): drift details should be recorded as an event: -want, +got:\n%s", diff)
+	}
+}
+
+func TestReconcilerPanicRecovery(t *testing.T) {
+	type want struct {
+		result reconcile.Result
+		synced xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason      string
+		deleting    bool
+		observation ExternalObservation
+		panics      string
+		want        want
+	}{
+		"Observe": {
+			reason: "A panic while observing the external resource should be recovered and reported.",
+			panics: "Observe",
+			want: want{
+				result: reconcile.Result{Requeue: true},
+				synced: xpv1.PanicRecovered(errors.Wrap(&panicError{value: "boom"}, errReconcileObserve)),
+			},
+		},
+		"Create": {
+			reason:      "A panic while creating the external resource should be recovered and reported.",
+			observation: ExternalObservation{ResourceExists: false},
+			panics:      "Create",
+			want: want{
+				result: reconcile.Result{Requeue: true},
+				synced: xpv1.PanicRecovered(errors.Wrap(&panicError{value: "boom"}, errReconcileCreate)),
+			},
+		},
+		"Update": {
+			reason:      "A panic while updating the external resource should be recovered and reported.",
+			observation: ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			panics:      "Update",
+			want: want{
+				result: reconcile.Result{Requeue: true},
+				synced: xpv1.PanicRecovered(errors.Wrap(&panicError{value: "boom"}, errReconcileUpdate)),
+			},
+		},
+		"Delete": {
+			reason:      "A panic while deleting the external resource should be recovered and reported.",
+			deleting:    true,
+			observation: ExternalObservation{ResourceExists: true},
+			panics:      "Delete",
+			want: want{
+				result: reconcile.Result{Requeue: true},
+				synced: xpv1.PanicRecovered(errors.Wrap(&panicError{value: "boom"}, errReconcileDelete)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rec := &recordingRecorder{}
+			var synced xpv1.Condition
+			m := &fake.Manager{
+				Client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						if tc.deleting {
+							mg := obj.(*fake.Managed)
+							mg.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+							mg.SetDeletionPolicy(xpv1.DeletionDelete)
+						}
+						return nil
+					}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+					MockStatusUpdate: test.MockStatusUpdateFn(func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						synced = obj.(*fake.Managed).GetCondition(xpv1.TypeSynced)
+						return nil
+					}),
+				},
+				Scheme: fake.SchemeWith(&fake.Managed{}),
+			}
+			boom := func(op string) {
+				if op == tc.panics {
+					panic("boom")
+				}
+			}
+			r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithRecorder(rec),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					c := &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							boom("Observe")
+							return tc.observation, nil
+						},
+						CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+							boom("Create")
+							return ExternalCreation{}, nil
+						},
+						UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+							boom("Update")
+							return ExternalUpdate{}, nil
+						},
+						DeleteFn: func(_ context.Context, _ resource.Managed) error {
+							boom("Delete")
+							return nil
+						},
+					}
+					return c, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				WithPanicRecovery(),
+			)
+
+			got, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if err != nil {
+				t.Fatalf("\n%s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want result, +got result:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.synced, synced); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want Synced condition, +got Synced condition:\n%s", tc.reason, diff)
+			}
+
+			var recovered []event.Event
+			for _, e := range rec.events {
+				if e.Reason == reasonPanicRecovered {
+					recovered = append(recovered, e)
+				}
+			}
+			if len(recovered) != 1 {
+				t.Fatalf("\n%s\nr.Reconcile(...): want 1 %s event, got %d", tc.reason, reasonPanicRecovered, len(recovered))
+			}
+			if recovered[0].Type != event.TypeWarning {
+				t.Errorf("\n%s\nr.Reconcile(...): want %s event of type %s, got %s", tc.reason, reasonPanicRecovered, event.TypeWarning, recovered[0].Type)
+			}
+			if msg := recovered[0].Message; !strings.HasPrefix(msg, "boom\n") || !strings.Contains(msg, "runtime/debug.Stack") {
+				t.Errorf("\n%s\nr.Reconcile(...): want %s event message to include the panic value and stack trace, got:\n%s", tc.reason, reasonPanicRecovered, msg)
+			}
+		})
+	}
+}
+
+func TestReconcilerPanicWithoutRecovery(t *testing.T) {
+	m := &fake.Manager{
+		Client: &test.MockClient{
+			MockGet:          test.NewMockGetFn(nil),
+			MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+		},
+		Scheme: fake.SchemeWith(&fake.Managed{}),
+	}
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			c := &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					panic("boom")
+				},
+			}
+			return c, nil
+		})),
+	)
+
+	defer func() {
+		if diff := cmp.Diff("boom", recover()); diff != "" {
+			t.Errorf("r.Reconcile(...): panics should propagate when panic recovery is not enabled: -want, +got:\n%s", diff)
+		}
+	}()
+	_, _ = r.Reconcile(context.Background(), reconcile.Request{})
+}
+
+func TestReconcilerAuditSink(t *testing.T) {
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "cool"}}
+	gvk := fake.GVK(&fake.Managed{})
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason          string
+		annotations     map[string]string
+		observation     ExternalObservation
+		observeErr      error
+		createErr       error
+		statusUpdateErr error
+		want            []AuditRecord
+	}{
+		"Paused": {
+			reason:      "A paused managed resource should be audited as not acted upon.",
+			annotations: map[string]string{meta.AnnotationKeyReconciliationPaused: "true"},
+			want:        []AuditRecord{{GVK: gvk, Name: req.NamespacedName, Action: AuditActionNone, Reason: auditReasonPaused}},
+		},
+		"Create": {
+			reason:      "A managed resource whose external resource does not exist should be audited as created.",
+			observation: ExternalObservation{ResourceExists: false},
+			want:        []AuditRecord{{GVK: gvk, Name: req.NamespacedName, Action: AuditActionCreate, Reason: auditReasonNotExist}},
+		},
+		"UpToDate": {
+			reason:      "A managed resource whose external resource is up to date should be audited as not acted upon.",
+			observation: ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			want:        []AuditRecord{{GVK: gvk, Name: req.NamespacedName, Action: AuditActionNone, Reason: auditReasonUpToDate}},
+		},
+		"Update": {
+			reason:      "A managed resource whose external resource is not up to date should be audited as updated.",
+			observation: ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			want:        []AuditRecord{{GVK: gvk, Name: req.NamespacedName, Action: AuditActionUpdate, Reason: auditReasonNotUpToDate}},
+		},
+		"ObserveError": {
+			reason:     "A managed resource whose external resource cannot be observed should be audited with the error.",
+			observeErr: errBoom,
+			want:       []AuditRecord{{GVK: gvk, Name: req.NamespacedName, Action: AuditActionNone, Reason: auditReasonNotReconciled, Error: errors.New(errors.Wrap(errBoom, errReconcileObserve).Error())}},
+		},
+		"CreateError": {
+			reason:      "A managed resource whose external resource cannot be created should be audited as created with the error.",
+			observation: ExternalObservation{ResourceExists: false},
+			createErr:   errBoom,
+			want:        []AuditRecord{{GVK: gvk, Name: req.NamespacedName, Action: AuditActionCreate, Reason: auditReasonNotExist, Error: errors.New(errors.Wrap(errBoom, errReconcileCreate).Error())}},
+		},
+		"StatusUpdateError": {
+			reason:          "A managed resource whose status cannot be updated should be audited with the returned error.",
+			observation:     ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			statusUpdateErr: errBoom,
+			want:            []AuditRecord{{GVK: gvk, Name: req.NamespacedName, Action: AuditActionNone, Reason: auditReasonUpToDate, Error: errors.Wrap(errBoom, errUpdateManagedStatus)}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []AuditRecord
+			m := &fake.Manager{
+				Client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						obj.SetAnnotations(tc.annotations)
+						return nil
+					}),
+					MockUpdate:       test.NewMockUpdateFn(nil),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(tc.statusUpdateErr),
+				},
+				Scheme: fake.SchemeWith(&fake.Managed{}),
+			}
+			r := NewReconciler(m, resource.ManagedKind(gvk),
+				WithPauseAnnotation(),
+				WithAuditSink(func(_ context.Context, a AuditRecord) { got = append(got, a) }),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					c := &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return tc.observation, tc.observeErr
+						},
+						CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+							return ExternalCreation{}, tc.createErr
+						},
+						UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+							return ExternalUpdate{}, nil
+						},
+					}
+					return c, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+
+			_, _ = r.Reconcile(context.Background(), req)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want audit records, +got audit records:\n%s", tc.reason, diff)
 			}
 		})
 	}
@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// A panicError is returned by a panicRecoveringExternalClient when the
+// ExternalClient it wraps panics.
+type panicError struct {
+	value any
+}
+
+func (e *panicError) Error() string {
+	return fmt.Sprintf("recovered from panic: %v", e.value)
+}
+
+// A panicRecoveringExternalClient recovers from panics in the ExternalClient it
+// wraps, returning them as errors.
+type panicRecoveringExternalClient struct {
+	client ExternalClient
+	record event.Recorder
+}
+
+func (c *panicRecoveringExternalClient) Observe(ctx context.Context, mg resource.Managed) (o ExternalObservation, err error) {
+	defer c.recover(mg, &err)
+	return c.client.Observe(ctx, mg)
+}
+
+func (c *panicRecoveringExternalClient) Create(ctx context.Context, mg resource.Managed) (cr ExternalCreation, err error) {
+	defer c.recover(mg, &err)
+	return c.client.Create(ctx, mg)
+}
+
+func (c *panicRecoveringExternalClient) Update(ctx context.Context, mg resource.Managed) (u ExternalUpdate, err error) {
+	defer c.recover(mg, &err)
+	return c.client.Update(ctx, mg)
+}
+
+func (c *panicRecoveringExternalClient) Delete(ctx context.Context, mg resource.Managed) (err error) {
+	defer c.recover(mg, &err)
+	return c.client.Delete(ctx, mg)
+}
+
+// recover must be deferred directly by the method it protects.
+func (c *panicRecoveringExternalClient) recover(mg resource.Managed, err *error) {
+	p := recover()
+	if p == nil {
+		return
+	}
+	c.record.Event(mg, event.Warning(reasonPanicRecovered, errors.Errorf("%v\n%s", p, debug.Stack())))
+	*err = &panicError{value: p}
+}
+
+// externalError returns the Synced condition that should be set when a call to
+// an ExternalClient using the supplied context returns the supplied error.
+func externalError(ctx context.Context, err error) xpv1.Condition {
+	pe := &panicError{}
+	if errors.As(err, &pe) {
+		return xpv1.PanicRecovered(err)
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return xpv1.ReconcileTimeout(err)
+	}
+	return xpv1.ReconcileError(err)
+}
@@ -18,6 +18,7 @@ package reference
 
 import (
 	"context"
+	"sort"
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 
@@ -38,6 +39,33 @@ const (
 	errNoValue     = "referenced field was empty (referenced resource may not yet be ready)"
 )
 
+// A pendingError indicates that a reference could not be resolved because the
+// referenced resource does not exist or is not yet ready.
+type pendingError struct {
+	error
+}
+
+func (e *pendingError) Unwrap() error {
+	return e.error
+}
+
+func errPending(msg string) error {
+	return pending(errors.New(msg))
+}
+
+func pending(err error) error {
+	return &pendingError{error: err}
+}
+
+// IsPending returns true if the supplied error indicates that a reference could
+// not be resolved because the referenced resource does not exist, no resources
+// matched its selector, or the referenced resource is not yet ready, rather
+// than because resolution failed.
+func IsPending(err error) bool {
+	pe := &pendingError{}
+	return errors.As(err, &pe)
+}
+
 // NOTE(negz): There are many equivalents of FromPtrValue and ToPtrValue
 // throughout the Crossplane codebase. We duplicate them here to reduce the
 // number of packages our API types have to import to support references.
@@ -150,7 +178,7 @@ type ResolutionResponse struct {
 // Validate this ResolutionResponse.
 func (rr ResolutionResponse) Validate() error {
 	if rr.ResolvedValue == "" {
-		return errors.New(errNoValue)
+		return errPending(errNoValue)
 	}
 
 	return nil
@@ -209,12 +237,12 @@ type MultiResolutionResponse struct {
 // Validate this MultiResolutionResponse.
 func (rr MultiResolutionResponse) Validate() error {
 	if len(rr.ResolvedValues) == 0 {
-		return errors.New(errNoMatches)
+		return errPending(errNoMatches)
 	}
 
 	for i, v := range rr.ResolvedValues {
 		if v == "" {
-			return getResolutionError(rr.ResolvedReferences[i].Policy, errors.New(errNoValue))
+			return getResolutionError(rr.ResolvedReferences[i].Policy, errPending(errNoValue))
 		}
 	}
 
@@ -225,14 +253,33 @@ func (rr MultiResolutionResponse) Validate() error {
 // Kubernetes API server.
 type APIResolver struct {
 	client client.Reader
+	live   client.Reader
 	from   resource.Managed
 }
 
+// An APIResolverOption configures an APIResolver.
+type APIResolverOption func(r *APIResolver)
+
+// WithLiveReferenceFallback configures the APIResolver to read from the
+// supplied reader, which should read directly from the API server, when its
+// client (typically backed by a possibly stale cache) yields no match for a
+// required reference. This allows references to freshly created resources to
+// be resolved without waiting for the cache to catch up.
+func WithLiveReferenceFallback(reader client.Reader) APIResolverOption {
+	return func(r *APIResolver) {
+		r.live = reader
+	}
+}
+
 // NewAPIResolver returns a Resolver that selects and resolves references from
 // the supplied managed resource to other managed resources in the Kubernetes
 // API server.
-func NewAPIResolver(c client.Reader, from resource.Managed) *APIResolver {
-	return &APIResolver{client: c, from: from}
+func NewAPIResolver(c client.Reader, from resource.Managed, o ...APIResolverOption) *APIResolver {
+	r := &APIResolver{client: c, from: from}
+	for _, ro := range o {
+		ro(r)
+	}
+	return r
 }
 
 // Resolve the supplied ResolutionRequest. The returned ResolutionResponse
@@ -245,9 +292,9 @@ func (r *APIResolver) Resolve(ctx context.Context, req ResolutionRequest) (Resol
 
 	// The reference is already set - resolve it.
 	if req.Reference != nil {
-		if err := r.client.Get(ctx, types.NamespacedName{Name: req.Reference.Name}, req.To.Managed); err != nil {
+		if err := r.get(ctx, req.Reference.Policy, req.Reference.Name, req.To.Managed); err != nil {
 			if kerrors.IsNotFound(err) {
-				return ResolutionResponse{}, getResolutionError(req.Reference.Policy, errors.Wrap(err, errGetManaged))
+				return ResolutionResponse{}, getResolutionError(req.Reference.Policy, pending(errors.Wrap(err, errGetManaged)))
 			}
 			return ResolutionResponse{}, errors.Wrap(err, errGetManaged)
 		}
@@ -257,21 +304,17 @@ func (r *APIResolver) Resolve(ctx context.Context, req ResolutionRequest) (Resol
 	}
 
 	// The reference was not set, but a selector was. Select a reference.
-	if err := r.client.List(ctx, req.To.List, client.MatchingLabels(req.Selector.MatchLabels)); err != nil {
+	if err := r.list(ctx, req.Selector, req.To.List); err != nil {
 		return ResolutionResponse{}, errors.Wrap(err, errListManaged)
 	}
 
-	for _, to := range req.To.List.GetItems() {
-		if ControllersMustMatch(req.Selector) && !meta.HaveSameController(r.from, to) {
-			continue
-		}
-
+	for _, to := range r.candidates(req.Selector, req.To.List) {
 		rsp := ResolutionResponse{ResolvedValue: req.Extract(to), ResolvedReference: &xpv1.Reference{Name: to.GetName()}}
 		return rsp, getResolutionError(req.Selector.Policy, rsp.Validate())
 	}
 
 	// We couldn't resolve anything.
-	return ResolutionResponse{}, getResolutionError(req.Selector.Policy, errors.New(errNoMatches))
+	return ResolutionResponse{}, getResolutionError(req.Selector.Policy, errPending(errNoMatches))
 
 }
 
@@ -288,9 +331,9 @@ func (r *APIResolver) ResolveMultiple(ctx context.Context, req MultiResolutionRe
 	if len(req.References) > 0 {
 		vals := make([]string, len(req.References))
 		for i := range req.References {
-			if err := r.client.Get(ctx, types.NamespacedName{Name: req.References[i].Name}, req.To.Managed); err != nil {
+			if err := r.get(ctx, req.References[i].Policy, req.References[i].Name, req.To.Managed); err != nil {
 				if kerrors.IsNotFound(err) {
-					return MultiResolutionResponse{}, getResolutionError(req.References[i].Policy, errors.Wrap(err, errGetManaged))
+					return MultiResolutionResponse{}, getResolutionError(req.References[i].Policy, pending(errors.Wrap(err, errGetManaged)))
 				}
 				return MultiResolutionResponse{}, errors.Wrap(err, errGetManaged)
 			}
@@ -302,18 +345,14 @@ func (r *APIResolver) ResolveMultiple(ctx context.Context, req MultiResolutionRe
 	}
 
 	// No references were set, but a selector was. Select and resolve references.
-	if err := r.client.List(ctx, req.To.List, client.MatchingLabels(req.Selector.MatchLabels)); err != nil {
+	if err := r.list(ctx, req.Selector, req.To.List); err != nil {
 		return MultiResolutionResponse{}, errors.Wrap(err, errListManaged)
 	}
 
-	items := req.To.List.GetItems()
+	items := r.candidates(req.Selector, req.To.List)
 	refs := make([]xpv1.Reference, 0, len(items))
 	vals := make([]string, 0, len(items))
-	for _, to := range req.To.List.GetItems() {
-		if ControllersMustMatch(req.Selector) && !meta.HaveSameController(r.from, to) {
-			continue
-		}
-
+	for _, to := range items {
 		vals = append(vals, req.Extract(to))
 		refs = append(refs, xpv1.Reference{Name: to.GetName()})
 	}
@@ -322,6 +361,77 @@ func (r *APIResolver) ResolveMultiple(ctx context.Context, req MultiResolutionRe
 	return rsp, getResolutionError(req.Selector.Policy, rsp.Validate())
 }
 
+// get the named managed resource. If the resource is not found, the supplied
+// policy requires it, and a live fallback is configured, get it again using
+// the live reader.
+func (r *APIResolver) get(ctx context.Context, p *xpv1.Policy, name string, obj client.Object) error {
+	err := r.client.Get(ctx, types.NamespacedName{Name: name}, obj)
+	if r.live == nil || p.IsResolutionPolicyOptional() || !kerrors.IsNotFound(err) {
+		return err
+	}
+	return r.live.Get(ctx, types.NamespacedName{Name: name}, obj)
+}
+
+// list the managed resources matched by the supplied selector. If none are
+// candidates for selection, the selector's policy requires a match, and a live
+// fallback is configured, list them again using the live reader.
+func (r *APIResolver) list(ctx context.Context, s *xpv1.Selector, l resource.ManagedList) error {
+	if err := r.client.List(ctx, l, client.MatchingLabels(s.MatchLabels)); err != nil {
+		return err
+	}
+	if r.live == nil || s.Policy.IsResolutionPolicyOptional() || len(r.candidates(s, l)) > 0 {
+		return nil
+	}
+	return r.live.List(ctx, l, client.MatchingLabels(s.MatchLabels))
+}
+
+// candidates returns the items of the supplied list that are candidates for
+// selection by the supplied selector, sorted according to its selection
+// strategy.
+func (r *APIResolver) candidates(s *xpv1.Selector, l resource.ManagedList) []resource.Managed {
+	items := sortCandidates(s, l.GetItems())
+	candidates := make([]resource.Managed, 0, len(items))
+	for _, to := range items {
+		if ControllersMustMatch(s) && !meta.HaveSameController(r.from, to) {
+			continue
+		}
+		candidates = append(candidates, to)
+	}
+	return candidates
+}
+
+// sortCandidates sorts the supplied candidates for selection according to the
+// supplied Selector's selection strategy. Candidates are returned in the order
+// they were supplied if no strategy is specified.
+func sortCandidates(s *xpv1.Selector, candidates []resource.Managed) []resource.Managed {
+	if s == nil || s.SelectionStrategy == nil {
+		return candidates
+	}
+
+	byName := func(i, j int) bool { return candidates[i].GetName() < candidates[j].GetName() }
+	switch *s.SelectionStrategy {
+	case xpv1.SelectionStrategyOldest:
+		sort.SliceStable(candidates, func(i, j int) bool {
+			ti, tj := candidates[i].GetCreationTimestamp(), candidates[j].GetCreationTimestamp()
+			if ti.Equal(&tj) {
+				return byName(i, j)
+			}
+			return ti.Before(&tj)
+		})
+	case xpv1.SelectionStrategyNewest:
+		sort.SliceStable(candidates, func(i, j int) bool {
+			ti, tj := candidates[i].GetCreationTimestamp(), candidates[j].GetCreationTimestamp()
+			if ti.Equal(&tj) {
+				return byName(i, j)
+			}
+			return tj.Before(&ti)
+		})
+	case xpv1.SelectionStrategyLexical:
+		sort.SliceStable(candidates, byName)
+	}
+	return candidates
+}
+
 func getResolutionError(p *xpv1.Policy, err error) error {
 	if !p.IsResolutionPolicyOptional() {
 		return err
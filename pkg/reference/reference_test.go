@@ -19,10 +19,13 @@ package reference
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -88,6 +91,7 @@ func TestToAndFromPtrValues(t *testing.T) {
 
 func TestResolve(t *testing.T) {
 	errBoom := errors.New("boom")
+	errNotFound := kerrors.NewNotFound(schema.GroupResource{}, "cool")
 	now := metav1.Now()
 	value := "coolv"
 	ref := &xpv1.Reference{Name: "cool"}
@@ -101,6 +105,23 @@ func TestResolve(t *testing.T) {
 	meta.SetExternalName(controlled, value)
 	meta.AddControllerReference(controlled, meta.AsController(&xpv1.TypedReference{UID: types.UID("very-unique")}))
 
+	// Candidates whose names sort in the opposite order of their creation.
+	candidate := func(name string, created time.Time) resource.Managed {
+		mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)}}
+		meta.SetExternalName(mg, name)
+		return mg
+	}
+	candidates := func() []resource.Managed {
+		return []resource.Managed{
+			candidate("b", now.Add(-1*time.Hour)),
+			candidate("c", now.Add(-2*time.Hour)),
+			candidate("a", now.Time),
+		}
+	}
+	oldest := xpv1.SelectionStrategyOldest
+	newest := xpv1.SelectionStrategyNewest
+	lexical := xpv1.SelectionStrategyLexical
+
 	type args struct {
 		ctx context.Context
 		req ResolutionRequest
@@ -112,6 +133,7 @@ func TestResolve(t *testing.T) {
 	cases := map[string]struct {
 		reason string
 		c      client.Reader
+		live   client.Reader
 		from   resource.Managed
 		args   args
 		want   want
@@ -191,6 +213,23 @@ func TestResolve(t *testing.T) {
 				err: errors.Wrap(errBoom, errGetManaged),
 			},
 		},
+		"GetNotFound": {
+			reason: "Should return a pending error if the referenced resource does not exist",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(errNotFound),
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: ResolutionRequest{
+					Reference: ref,
+					To:        To{Managed: &fake.Managed{}},
+					Extract:   ExternalName(),
+				},
+			},
+			want: want{
+				err: pending(errors.Wrap(errNotFound, errGetManaged)),
+			},
+		},
 		"ResolvedNoValue": {
 			reason: "Should return an error if the extract function returns the empty string",
 			c: &test.MockClient{
@@ -208,7 +247,7 @@ func TestResolve(t *testing.T) {
 				rsp: ResolutionResponse{
 					ResolvedReference: ref,
 				},
-				err: errors.New(errNoValue),
+				err: errPending(errNoValue),
 			},
 		},
 		"SuccessfulResolve": {
@@ -284,7 +323,7 @@ func TestResolve(t *testing.T) {
 			},
 			want: want{
 				rsp: ResolutionResponse{},
-				err: errors.New(errNoMatches),
+				err: errPending(errNoMatches),
 			},
 		},
 		"OptionalSelector": {
@@ -387,10 +426,182 @@ func TestResolve(t *testing.T) {
 				},
 			},
 		},
+		"SelectOldest": {
+			reason: "The oldest matching managed resource should be selected when the selection strategy is Oldest",
+			c: &test.MockClient{
+				MockList: test.NewMockListFn(nil),
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: ResolutionRequest{
+					Selector: &xpv1.Selector{SelectionStrategy: &oldest},
+					To:       To{List: &FakeManagedList{Items: candidates()}},
+					Extract:  ExternalName(),
+				},
+			},
+			want: want{
+				rsp: ResolutionResponse{
+					ResolvedValue:     "c",
+					ResolvedReference: &xpv1.Reference{Name: "c"},
+				},
+			},
+		},
+		"SelectNewest": {
+			reason: "The newest matching managed resource should be selected when the selection strategy is Newest",
+			c: &test.MockClient{
+				MockList: test.NewMockListFn(nil),
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: ResolutionRequest{
+					Selector: &xpv1.Selector{SelectionStrategy: &newest},
+					To:       To{List: &FakeManagedList{Items: candidates()}},
+					Extract:  ExternalName(),
+				},
+			},
+			want: want{
+				rsp: ResolutionResponse{
+					ResolvedValue:     "a",
+					ResolvedReference: &xpv1.Reference{Name: "a"},
+				},
+			},
+		},
+		"SelectLexical": {
+			reason: "The matching managed resource whose name sorts first should be selected when the selection strategy is Lexical",
+			c: &test.MockClient{
+				MockList: test.NewMockListFn(nil),
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: ResolutionRequest{
+					Selector: &xpv1.Selector{SelectionStrategy: &lexical},
+					To:       To{List: &FakeManagedList{Items: candidates()}},
+					Extract:  ExternalName(),
+				},
+			},
+			want: want{
+				rsp: ResolutionResponse{
+					ResolvedValue:     "a",
+					ResolvedReference: &xpv1.Reference{Name: "a"},
+				},
+			},
+		},
+		"SelectDefault": {
+			reason: "The first matching managed resource should be selected when no selection strategy is specified",
+			c: &test.MockClient{
+				MockList: test.NewMockListFn(nil),
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: ResolutionRequest{
+					Selector: &xpv1.Selector{},
+					To:       To{List: &FakeManagedList{Items: candidates()}},
+					Extract:  ExternalName(),
+				},
+			},
+			want: want{
+				rsp: ResolutionResponse{
+					ResolvedValue:     "b",
+					ResolvedReference: &xpv1.Reference{Name: "b"},
+				},
+			},
+		},
+		"LiveFallbackGet": {
+			reason: "Should get a required referenced resource using the live reader if the cache can't find it",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(errNotFound),
+			},
+			live: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					meta.SetExternalName(obj.(metav1.Object), value)
+					return nil
+				}),
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: ResolutionRequest{
+					Reference: ref,
+					To:        To{Managed: &fake.Managed{}},
+					Extract:   ExternalName(),
+				},
+			},
+			want: want{
+				rsp: ResolutionResponse{
+					ResolvedValue:     value,
+					ResolvedReference: ref,
+				},
+			},
+		},
+		"LiveFallbackGetOptional": {
+			reason: "Should not use the live reader to get an optional referenced resource",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(errNotFound),
+			},
+			live: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: ResolutionRequest{
+					Reference: optionalRef,
+					To:        To{Managed: &fake.Managed{}},
+					Extract:   ExternalName(),
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"LiveFallbackList": {
+			reason: "Should list candidates using the live reader if the cache yields no match for a required selector",
+			c: &test.MockClient{
+				MockList: test.NewMockListFn(nil),
+			},
+			live: &test.MockClient{
+				MockList: test.NewMockListFn(nil, func(obj client.ObjectList) error {
+					obj.(*FakeManagedList).Items = candidates()
+					return nil
+				}),
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: ResolutionRequest{
+					Selector: &xpv1.Selector{},
+					To:       To{List: &FakeManagedList{}},
+					Extract:  ExternalName(),
+				},
+			},
+			want: want{
+				rsp: ResolutionResponse{
+					ResolvedValue:     "b",
+					ResolvedReference: &xpv1.Reference{Name: "b"},
+				},
+			},
+		},
+		"LiveFallbackListError": {
+			reason: "Should return errors encountered while listing candidates using the live reader",
+			c: &test.MockClient{
+				MockList: test.NewMockListFn(nil),
+			},
+			live: &test.MockClient{
+				MockList: test.NewMockListFn(errBoom),
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: ResolutionRequest{
+					Selector: &xpv1.Selector{},
+					To:       To{List: &FakeManagedList{}},
+					Extract:  ExternalName(),
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errListManaged),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			r := NewAPIResolver(tc.c, tc.from)
+			r := NewAPIResolver(tc.c, tc.from, WithLiveReferenceFallback(tc.live))
 			got, err := r.Resolve(tc.args.ctx, tc.args.req)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\nControllersMustMatch(...): -want error, +got error:\n%s", tc.reason, diff)
@@ -403,6 +614,7 @@ func TestResolve(t *testing.T) {
 }
 func TestResolveMultiple(t *testing.T) {
 	errBoom := errors.New("boom")
+	errNotFound := kerrors.NewNotFound(schema.GroupResource{}, "cool")
 	now := metav1.Now()
 	value := "coolv"
 	ref := xpv1.Reference{Name: "cool"}
@@ -427,6 +639,7 @@ func TestResolveMultiple(t *testing.T) {
 	cases := map[string]struct {
 		reason string
 		c      client.Reader
+		live   client.Reader
 		from   resource.Managed
 		args   args
 		want   want
@@ -506,6 +719,23 @@ func TestResolveMultiple(t *testing.T) {
 				err: errors.Wrap(errBoom, errGetManaged),
 			},
 		},
+		"GetNotFound": {
+			reason: "Should return a pending error if a referenced resource does not exist",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(errNotFound),
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: MultiResolutionRequest{
+					References: []xpv1.Reference{ref},
+					To:         To{Managed: &fake.Managed{}},
+					Extract:    ExternalName(),
+				},
+			},
+			want: want{
+				err: pending(errors.Wrap(errNotFound, errGetManaged)),
+			},
+		},
 		"ResolvedNoValue": {
 			reason: "Should return an error if the extract function returns the empty string",
 			c: &test.MockClient{
@@ -524,7 +754,7 @@ func TestResolveMultiple(t *testing.T) {
 					ResolvedValues:     []string{""},
 					ResolvedReferences: []xpv1.Reference{ref},
 				},
-				err: errors.New(errNoValue),
+				err: errPending(errNoValue),
 			},
 		},
 		"SuccessfulResolve": {
@@ -601,7 +831,7 @@ func TestResolveMultiple(t *testing.T) {
 			},
 			want: want{
 				rsp: MultiResolutionResponse{},
-				err: errors.New(errNoMatches),
+				err: errPending(errNoMatches),
 			},
 		},
 		"OptionalSelector": {
@@ -704,10 +934,36 @@ func TestResolveMultiple(t *testing.T) {
 				},
 			},
 		},
+		"LiveFallbackList": {
+			reason: "Should list candidates using the live reader if the cache yields no match for a required selector",
+			c: &test.MockClient{
+				MockList: test.NewMockListFn(nil),
+			},
+			live: &test.MockClient{
+				MockList: test.NewMockListFn(nil, func(obj client.ObjectList) error {
+					obj.(*FakeManagedList).Items = []resource.Managed{controlled}
+					return nil
+				}),
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: MultiResolutionRequest{
+					Selector: &xpv1.Selector{},
+					To:       To{List: &FakeManagedList{}},
+					Extract:  ExternalName(),
+				},
+			},
+			want: want{
+				rsp: MultiResolutionResponse{
+					ResolvedValues:     []string{value},
+					ResolvedReferences: []xpv1.Reference{{Name: value}},
+				},
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			r := NewAPIResolver(tc.c, tc.from)
+			r := NewAPIResolver(tc.c, tc.from, WithLiveReferenceFallback(tc.live))
 			got, err := r.ResolveMultiple(tc.args.ctx, tc.args.req)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\nControllersMustMatch(...): -want error, +got error:\n%s", tc.reason, diff)
@@ -750,3 +1006,39 @@ func TestControllersMustMatch(t *testing.T) {
 		})
 	}
 }
+
+func TestIsPending(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil": {
+			err:  nil,
+			want: false,
+		},
+		"OtherError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+		"Pending": {
+			err:  errPending(errNoValue),
+			want: true,
+		},
+		"NotFound": {
+			err:  pending(errors.Wrap(kerrors.NewNotFound(schema.GroupResource{}, "cool"), errGetManaged)),
+			want: true,
+		},
+		"WrappedPending": {
+			err:  errors.Wrap(errPending(errNoMatches), "spec.forProvider.networkId"),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsPending(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsPending(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
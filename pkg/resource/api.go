@@ -19,14 +19,18 @@ package resource
 import (
 	"context"
 	"encoding/json"
+	"sync"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 )
@@ -38,7 +42,8 @@ const (
 	errUpdateSecret         = "cannot update connection secret"
 	errCreateOrUpdateSecret = "cannot create or update connection secret"
 
-	errUpdateObject = "cannot update object"
+	errUpdateObject  = "cannot update object"
+	errRetryConflict = "cannot apply object after retrying conflicts"
 )
 
 // An APIManagedConnectionPropagator propagates connection details by reading
@@ -106,6 +111,35 @@ func (a *APIConnectionPropagator) PropagateConnection(ctx context.Context, to Lo
 	return errors.Wrap(a.client.Update(ctx, fs), errUpdateSecret)
 }
 
+// EnsureConnectionSecretOwner ensures the supplied managed resource is the
+// controller of the referenced connection secret, so that the secret will be
+// garbage collected when the managed resource is deleted. This is useful for
+// connection secrets written by older managed resources, which may not have a
+// controller reference. Secrets that do not exist or that are already
+// controlled by any resource, including a different resource, are not
+// modified.
+func EnsureConnectionSecretOwner(ctx context.Context, c client.Client, mg Managed, ref xpv1.SecretReference) error {
+	s := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return errors.Wrap(IgnoreNotFound(err), errGetSecret)
+	}
+
+	// Either the managed resource is already the controller of this secret,
+	// or it's controlled by something else. In both cases there's nothing to
+	// do.
+	if metav1.GetControllerOf(s) != nil {
+		return nil
+	}
+
+	kind, err := GetKind(mg, c.Scheme())
+	if err != nil {
+		return err
+	}
+
+	meta.AddOwnerReference(s, meta.AsController(meta.TypedReferenceTo(mg, kind)))
+	return errors.Wrap(c.Update(ctx, s), errUpdateSecret)
+}
+
 // An APIPatchingApplicator applies changes to an object by either creating or
 // patching it in a Kubernetes API server.
 type APIPatchingApplicator struct {
@@ -160,13 +194,32 @@ func (p *patch) Data(_ client.Object) ([]byte, error) { return json.Marshal(p.fr
 // An APIUpdatingApplicator applies changes to an object by either creating or
 // updating it in a Kubernetes API server.
 type APIUpdatingApplicator struct {
-	client client.Client
+	client        client.Client
+	mergeMetadata bool
+}
+
+// An APIUpdatingApplicatorOption configures an APIUpdatingApplicator.
+type APIUpdatingApplicatorOption func(*APIUpdatingApplicator)
+
+// WithMetadataMerge configures an APIUpdatingApplicator to merge the labels and
+// annotations of the desired object with those of the current object, rather
+// than replacing them. Keys set by the desired object take precedence. Keys
+// that are only set on the current object, for example by other controllers,
+// are preserved.
+func WithMetadataMerge() APIUpdatingApplicatorOption {
+	return func(a *APIUpdatingApplicator) {
+		a.mergeMetadata = true
+	}
 }
 
 // NewAPIUpdatingApplicator returns an Applicator that applies changes to an
 // object by either creating or updating it in a Kubernetes API server.
-func NewAPIUpdatingApplicator(c client.Client) *APIUpdatingApplicator {
-	return &APIUpdatingApplicator{client: c}
+func NewAPIUpdatingApplicator(c client.Client, o ...APIUpdatingApplicatorOption) *APIUpdatingApplicator {
+	a := &APIUpdatingApplicator{client: c}
+	for _, fn := range o {
+		fn(a)
+	}
+	return a
 }
 
 // Apply changes to the supplied object. The object will be created if it does
@@ -198,12 +251,180 @@ func (a *APIUpdatingApplicator) Apply(ctx context.Context, o client.Object, ao .
 		}
 	}
 
+	if a.mergeMetadata {
+		m.SetLabels(mergeStringMaps(current.(metav1.Object).GetLabels(), m.GetLabels()))
+		m.SetAnnotations(mergeStringMaps(current.(metav1.Object).GetAnnotations(), m.GetAnnotations()))
+	}
+
 	// NOTE(hasheddan): we must set the resource version of the desired object
 	// to that of the current or the update will always fail.
 	m.SetResourceVersion(current.(metav1.Object).GetResourceVersion())
 	return errors.Wrap(a.client.Update(ctx, m), "cannot update object")
 }
 
+// mergeStringMaps returns the union of the supplied maps. Values in desired
+// take precedence over those in current.
+func mergeStringMaps(current, desired map[string]string) map[string]string {
+	if len(current) == 0 {
+		return desired
+	}
+	merged := make(map[string]string, len(current)+len(desired))
+	for k, v := range current {
+		merged[k] = v
+	}
+	for k, v := range desired {
+		merged[k] = v
+	}
+	return merged
+}
+
+// NewDryRunClient returns a client that asks the API server to dry-run all
+// writes. Dry-run writes are validated and admitted as usual, and return the
+// object as it would have been persisted, but are never persisted. Reads are
+// unaffected.
+func NewDryRunClient(c client.Client) client.Client {
+	return &dryRunClient{Client: c}
+}
+
+type dryRunClient struct {
+	client.Client
+}
+
+func (c *dryRunClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.Client.Create(ctx, obj, append(opts, client.DryRunAll)...)
+}
+
+func (c *dryRunClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.Client.Update(ctx, obj, append(opts, client.DryRunAll)...)
+}
+
+func (c *dryRunClient) Patch(ctx context.Context, obj client.Object, p client.Patch, opts ...client.PatchOption) error {
+	return c.Client.Patch(ctx, obj, p, append(opts, client.DryRunAll)...)
+}
+
+func (c *dryRunClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return c.Client.Delete(ctx, obj, append(opts, client.DryRunAll)...)
+}
+
+func (c *dryRunClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	return c.Client.DeleteAllOf(ctx, obj, append(opts, client.DryRunAll)...)
+}
+
+func (c *dryRunClient) Status() client.StatusWriter {
+	return &dryRunStatusWriter{StatusWriter: c.Client.Status()}
+}
+
+type dryRunStatusWriter struct {
+	client.StatusWriter
+}
+
+func (w *dryRunStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return w.StatusWriter.Update(ctx, obj, append(opts, client.DryRunAll)...)
+}
+
+func (w *dryRunStatusWriter) Patch(ctx context.Context, obj client.Object, p client.Patch, opts ...client.PatchOption) error {
+	return w.StatusWriter.Patch(ctx, obj, p, append(opts, client.DryRunAll)...)
+}
+
+// A DryRunApplicator plans changes to an object without applying them. It
+// applies a copy of the object to a Kubernetes API server using a client that
+// dry-runs all writes, and records the result.
+type DryRunApplicator struct {
+	inner Applicator
+
+	mu      sync.Mutex
+	planned client.Object
+	patch   []byte
+}
+
+// NewDryRunApplicator returns an Applicator that plans changes to an object by
+// applying a copy of it to the API server using an APIPatchingApplicator whose
+// writes are all dry-run. The object it would have applied is available via
+// LastPlanned, and the patch it would have applied to the current object via
+// LastPatch.
+func NewDryRunApplicator(c client.Client) *DryRunApplicator {
+	return &DryRunApplicator{inner: NewAPIPatchingApplicator(NewDryRunClient(c))}
+}
+
+// Apply plans changes to the supplied object. The supplied object is not
+// modified.
+func (a *DryRunApplicator) Apply(ctx context.Context, o client.Object, ao ...ApplyOption) error {
+	// Applicators pass the current object to their ApplyOptions only if it
+	// exists, so we record it in an option that runs before all others. If it
+	// is never recorded the object would be created.
+	var current client.Object
+	record := func(_ context.Context, c, _ runtime.Object) error {
+		current, _ = c.DeepCopyObject().(client.Object)
+		return nil
+	}
+
+	planned := o.DeepCopyObject().(client.Object)
+	if err := a.inner.Apply(ctx, planned, append([]ApplyOption{record}, ao...)...); err != nil {
+		return err
+	}
+
+	patch, err := mergePatch(current, planned)
+	if err != nil {
+		return errors.Wrap(err, "cannot compute planned patch")
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.planned = planned
+	a.patch = patch
+	return nil
+}
+
+// LastPlanned returns the object the DryRunApplicator would have applied the
+// last time Apply was called without error, or nil if it has yet to plan one.
+func (a *DryRunApplicator) LastPlanned() client.Object {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.planned
+}
+
+// LastPatch returns the JSON merge patch from the current object to the one
+// the DryRunApplicator would have applied the last time Apply was called
+// without error, or nil if it has yet to plan one. The patch contains the
+// entire planned object if the object would have been created. Metadata that
+// is set by the API server, like the resource version, is omitted.
+func (a *DryRunApplicator) LastPatch() []byte {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.patch
+}
+
+// mergePatch returns a JSON merge patch from the current object to the
+// planned one, ignoring metadata set by the API server. A nil current object
+// is treated as an empty one.
+func mergePatch(current, planned client.Object) ([]byte, error) {
+	from := []byte("{}")
+	if current != nil {
+		j, err := json.Marshal(withoutServerMetadata(current))
+		if err != nil {
+			return nil, err
+		}
+		from = j
+	}
+	to, err := json.Marshal(withoutServerMetadata(planned))
+	if err != nil {
+		return nil, err
+	}
+	return jsonpatch.CreateMergePatch(from, to)
+}
+
+// withoutServerMetadata returns a copy of the supplied object without the
+// metadata the API server sets when it persists an object.
+func withoutServerMetadata(o client.Object) client.Object {
+	o = o.DeepCopyObject().(client.Object)
+	o.SetUID("")
+	o.SetResourceVersion("")
+	o.SetGeneration(0)
+	o.SetCreationTimestamp(metav1.Time{})
+	o.SetManagedFields(nil)
+	return o
+}
+
 // An APIFinalizer adds and removes finalizers to and from a resource.
 type APIFinalizer struct {
 	client    client.Client
@@ -227,22 +448,67 @@ func NewAPIFinalizer(c client.Client, finalizer string) *APIFinalizer {
 	return &APIFinalizer{client: c, finalizer: finalizer}
 }
 
-// AddFinalizer to the supplied Managed resource.
+// AddFinalizer to the supplied Managed resource. It does nothing if the
+// finalizer already exists. If the update conflicts the latest version of the
+// resource is read into a copy of the supplied resource, and the finalizer is
+// added to it if it still does not exist. Any pending changes to the supplied
+// resource are preserved.
 func (a *APIFinalizer) AddFinalizer(ctx context.Context, obj Object) error {
-	if meta.FinalizerExists(obj, a.finalizer) {
-		return nil
-	}
-	meta.AddFinalizer(obj, a.finalizer)
-	return errors.Wrap(a.client.Update(ctx, obj), errUpdateObject)
+	return errors.Wrap(a.update(ctx, obj, func(o Object) bool {
+		if meta.FinalizerExists(o, a.finalizer) {
+			return false
+		}
+		meta.AddFinalizer(o, a.finalizer)
+		return true
+	}), errUpdateObject)
 }
 
-// RemoveFinalizer from the supplied Managed resource.
+// RemoveFinalizer from the supplied Managed resource. It does nothing if the
+// finalizer does not exist, or if the resource no longer exists. If the update
+// conflicts the latest version of the resource is read into a copy of the
+// supplied resource, and the finalizer is removed from it if it still exists.
+// Any pending changes to the supplied resource are preserved.
 func (a *APIFinalizer) RemoveFinalizer(ctx context.Context, obj Object) error {
-	if !meta.FinalizerExists(obj, a.finalizer) {
-		return nil
+	return errors.Wrap(IgnoreNotFound(a.update(ctx, obj, func(o Object) bool {
+		if !meta.FinalizerExists(o, a.finalizer) {
+			return false
+		}
+		meta.RemoveFinalizer(o, a.finalizer)
+		return true
+	})), errUpdateObject)
+}
+
+// update calls the supplied function to mutate the supplied object, and
+// updates the object if the function returns true. Conflicting updates are
+// retried after getting the latest version of the object into a copy. Once
+// the copy is updated its finalizers and resource version are copied back to
+// the supplied object, so that the supplied object may be updated again
+// without losing any of its pending changes.
+func (a *APIFinalizer) update(ctx context.Context, obj Object, mutate func(o Object) bool) error {
+	o := obj
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if !mutate(o) {
+			return nil
+		}
+		err := a.client.Update(ctx, o)
+		if !kerrors.IsConflict(err) {
+			return err
+		}
+		// The object changed since we read it. Get its latest version into a
+		// copy, so that we don't lose any pending changes to the supplied
+		// object, and try again.
+		latest := obj.DeepCopyObject().(Object)
+		if err := a.client.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, latest); err != nil {
+			return err
+		}
+		o = latest
+		return err
+	})
+	if err == nil && o != obj {
+		obj.SetFinalizers(o.GetFinalizers())
+		obj.SetResourceVersion(o.GetResourceVersion())
 	}
-	meta.RemoveFinalizer(obj, a.finalizer)
-	return errors.Wrap(IgnoreNotFound(a.client.Update(ctx, obj)), errUpdateObject)
+	return err
 }
 
 // A FinalizerFns satisfy the Finalizer interface.
@@ -18,6 +18,7 @@ package resource
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -26,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -370,6 +372,7 @@ func TestAPIUpdatingApplicator(t *testing.T) {
 	cases := map[string]struct {
 		reason string
 		c      client.Client
+		o      []APIUpdatingApplicatorOption
 		args   args
 		want   want
 	}{
@@ -461,11 +464,49 @@ func TestAPIUpdatingApplicator(t *testing.T) {
 				o: desired,
 			},
 		},
+		"UpdatedWithMetadataMerge": {
+			reason: "Labels and annotations of the current object should be preserved when metadata merge is enabled, unless the desired object overrides them",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(o client.Object) error {
+					o.SetLabels(map[string]string{"current": "label", "shared": "current"})
+					o.SetAnnotations(map[string]string{"cert-manager.io/issuer": "cool"})
+					return nil
+				}),
+				MockUpdate: test.NewMockUpdateFn(nil, func(o client.Object) error {
+					want := &object{}
+					want.SetName("desired")
+					want.SetLabels(map[string]string{"current": "label", "shared": "desired", "desired": "label"})
+					want.SetAnnotations(map[string]string{"cert-manager.io/issuer": "cool"})
+					if diff := cmp.Diff(want, o.(*object)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil
+				}),
+			},
+			o: []APIUpdatingApplicatorOption{WithMetadataMerge()},
+			args: args{
+				o: func() client.Object {
+					o := &object{}
+					o.SetName("desired")
+					o.SetLabels(map[string]string{"shared": "desired", "desired": "label"})
+					return o
+				}(),
+			},
+			want: want{
+				o: func() client.Object {
+					o := &object{}
+					o.SetName("desired")
+					o.SetLabels(map[string]string{"current": "label", "shared": "desired", "desired": "label"})
+					o.SetAnnotations(map[string]string{"cert-manager.io/issuer": "cool"})
+					return o
+				}(),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			a := NewAPIUpdatingApplicator(tc.c)
+			a := NewAPIUpdatingApplicator(tc.c, tc.o...)
 			err := a.Apply(tc.args.ctx, tc.args.o, tc.args.ao...)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\nApply(...): -want error, +got error\n%s\n", tc.reason, diff)
@@ -477,6 +518,188 @@ func TestAPIUpdatingApplicator(t *testing.T) {
 	}
 }
 
+func TestDryRunApplicator(t *testing.T) {
+	errBoom := errors.New("boom")
+	desired := &object{}
+	desired.SetName("desired")
+	desired.SetLabels(map[string]string{"desired": "label"})
+	current := &object{}
+	current.SetName("desired")
+	current.SetResourceVersion("42")
+	current.SetGeneration(1)
+
+	// dryRun fails the test if the supplied options don't dry-run a write.
+	dryRun := func(t *testing.T, opts []string) {
+		t.Helper()
+		if diff := cmp.Diff([]string{metav1.DryRunAll}, opts); diff != "" {
+			t.Errorf("writes should be dry-run: -want, +got:\n%s", diff)
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		o   client.Object
+		ao  []ApplyOption
+	}
+
+	type want struct {
+		planned client.Object
+		patch   []byte
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      func(t *testing.T) client.Client
+		args   args
+		want   want
+	}{
+		"GetError": {
+			reason: "Errors getting the current object should be returned, and nothing planned",
+			c: func(_ *testing.T) client.Client {
+				return &test.MockClient{MockGet: test.NewMockGetFn(errBoom)}
+			},
+			args: args{
+				o: desired.DeepCopyObject().(client.Object),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, "cannot get object"),
+			},
+		},
+		"PlannedCreate": {
+			reason: "An object that would be created should be planned in its entirety, without server set metadata",
+			c: func(t *testing.T) client.Client {
+				return &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+					MockCreate: func(_ context.Context, obj client.Object, opts ...client.CreateOption) error {
+						dryRun(t, (&client.CreateOptions{}).ApplyOptions(opts).DryRun)
+						obj.SetUID("no-you-id")
+						obj.SetResourceVersion("1")
+						return nil
+					},
+				}
+			},
+			args: args{
+				o: desired.DeepCopyObject().(client.Object),
+			},
+			want: want{
+				planned: func() client.Object {
+					o := desired.DeepCopyObject().(client.Object)
+					o.SetUID("no-you-id")
+					o.SetResourceVersion("1")
+					return o
+				}(),
+				patch: []byte(`{"Object":null,"creationTimestamp":null,"labels":{"desired":"label"},"name":"desired"}`),
+			},
+		},
+		"PlannedUpdate": {
+			reason: "An object that would be updated should be planned as a patch to the current object, without server set metadata",
+			c: func(t *testing.T) client.Client {
+				return &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(o client.Object) error {
+						*o.(*object) = *current.DeepCopyObject().(*object)
+						return nil
+					}),
+					MockPatch: func(_ context.Context, obj client.Object, p client.Patch, opts ...client.PatchOption) error {
+						dryRun(t, (&client.PatchOptions{}).ApplyOptions(opts).DryRun)
+						data, err := p.Data(obj)
+						if err != nil {
+							return err
+						}
+						if err := json.Unmarshal(data, obj); err != nil {
+							return err
+						}
+						obj.SetResourceVersion("43")
+						obj.SetGeneration(2)
+						return nil
+					},
+				}
+			},
+			args: args{
+				o: desired.DeepCopyObject().(client.Object),
+				ao: []ApplyOption{func(_ context.Context, _, desired runtime.Object) error {
+					desired.(metav1.Object).SetAnnotations(map[string]string{"applied": "option"})
+					return nil
+				}},
+			},
+			want: want{
+				planned: func() client.Object {
+					o := desired.DeepCopyObject().(client.Object)
+					o.SetAnnotations(map[string]string{"applied": "option"})
+					o.SetResourceVersion("43")
+					o.SetGeneration(2)
+					return o
+				}(),
+				patch: []byte(`{"annotations":{"applied":"option"},"labels":{"desired":"label"}}`),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			a := NewDryRunApplicator(tc.c(t))
+			o := tc.args.o.DeepCopyObject()
+			err := a.Apply(tc.args.ctx, tc.args.o, tc.args.ao...)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want error, +got error\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.planned, a.LastPlanned()); diff != "" {
+				t.Errorf("\n%s\nLastPlanned(): -want, +got\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(string(tc.want.patch), string(a.LastPatch())); diff != "" {
+				t.Errorf("\n%s\nLastPatch(): -want, +got\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(o, tc.args.o); diff != "" {
+				t.Errorf("\n%s\nApply(...): the supplied object should not be modified: -want, +got\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDryRunClient(t *testing.T) {
+	dryRun := func(t *testing.T, opts []string) {
+		t.Helper()
+		if diff := cmp.Diff([]string{metav1.DryRunAll}, opts); diff != "" {
+			t.Errorf("writes should be dry-run: -want, +got:\n%s", diff)
+		}
+	}
+	c := NewDryRunClient(&test.MockClient{
+		MockCreate: func(_ context.Context, _ client.Object, opts ...client.CreateOption) error {
+			dryRun(t, (&client.CreateOptions{}).ApplyOptions(opts).DryRun)
+			return nil
+		},
+		MockUpdate: func(_ context.Context, _ client.Object, opts ...client.UpdateOption) error {
+			dryRun(t, (&client.UpdateOptions{}).ApplyOptions(opts).DryRun)
+			return nil
+		},
+		MockPatch: func(_ context.Context, _ client.Object, _ client.Patch, opts ...client.PatchOption) error {
+			dryRun(t, (&client.PatchOptions{}).ApplyOptions(opts).DryRun)
+			return nil
+		},
+		MockDelete: func(_ context.Context, _ client.Object, opts ...client.DeleteOption) error {
+			dryRun(t, (&client.DeleteOptions{}).ApplyOptions(opts).DryRun)
+			return nil
+		},
+		MockStatusUpdate: func(_ context.Context, _ client.Object, opts ...client.UpdateOption) error {
+			dryRun(t, (&client.UpdateOptions{}).ApplyOptions(opts).DryRun)
+			return nil
+		},
+		MockStatusPatch: func(_ context.Context, _ client.Object, _ client.Patch, opts ...client.PatchOption) error {
+			dryRun(t, (&client.PatchOptions{}).ApplyOptions(opts).DryRun)
+			return nil
+		},
+	})
+
+	ctx := context.Background()
+	o := &object{}
+	_ = c.Create(ctx, o)
+	_ = c.Update(ctx, o)
+	_ = c.Patch(ctx, o, client.MergeFrom(o))
+	_ = c.Delete(ctx, o)
+	_ = c.Status().Update(ctx, o)
+	_ = c.Status().Patch(ctx, o, client.MergeFrom(o))
+}
+
 func TestManagedRemoveFinalizer(t *testing.T) {
 	finalizer := "veryfinal"
 
@@ -508,6 +731,20 @@ func TestManagedRemoveFinalizer(t *testing.T) {
 				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{}}},
 			},
 		},
+		"ConflictNotFound": {
+			client: &test.MockClient{
+				MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+				MockUpdate: test.NewMockUpdateFn(kerrors.NewConflict(schema.GroupResource{}, "", errBoom)),
+			},
+			args: args{
+				ctx: context.Background(),
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{finalizer}}},
+			},
+			want: want{
+				err: nil,
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{}}},
+			},
+		},
 		"Successful": {
 			client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
 			args: args{
@@ -566,6 +803,68 @@ func TestAPIFinalizerAdder(t *testing.T) {
 				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{finalizer}}},
 			},
 		},
+		"ConflictAlreadyAdded": {
+			client: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.SetFinalizers([]string{finalizer})
+					return nil
+				}),
+				MockUpdate: func() test.MockUpdateFn {
+					updated := false
+					return func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+						if updated {
+							return errBoom
+						}
+						updated = true
+						return kerrors.NewConflict(schema.GroupResource{}, "", errBoom)
+					}
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{}}},
+			},
+			want: want{
+				err: nil,
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{finalizer}}},
+			},
+		},
+		"ConflictPreservesPendingChanges": {
+			client: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.SetLabels(nil)
+					obj.SetFinalizers([]string{"other"})
+					obj.SetResourceVersion("2")
+					return nil
+				}),
+				MockUpdate: func() test.MockUpdateFn {
+					updated := false
+					return func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						if updated {
+							if diff := cmp.Diff([]string{"other", finalizer}, obj.GetFinalizers()); diff != "" {
+								t.Errorf("the latest version of the object should have the finalizer added: -want, +got:\n%s", diff)
+							}
+							// The API server returns the updated object,
+							// with a new resource version.
+							obj.SetResourceVersion("3")
+							return nil
+						}
+						updated = true
+						return kerrors.NewConflict(schema.GroupResource{}, "", errBoom)
+					}
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1", Labels: map[string]string{"pending": "change"}, Finalizers: []string{}}},
+			},
+			want: want{
+				err: nil,
+				// The supplied object should have the latest resource
+				// version, so that it can be updated again.
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "3", Labels: map[string]string{"pending": "change"}, Finalizers: []string{"other", finalizer}}},
+			},
+		},
 		"Successful": {
 			client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
 			args: args{
@@ -592,3 +891,143 @@ func TestAPIFinalizerAdder(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIFinalizerConflictThenUpdate(t *testing.T) {
+	// A client that, like the API server, rejects updates to anything but the
+	// latest resource version of an object.
+	stored := &fake.Object{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "2"}}
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			*obj.(*fake.Object) = *stored.DeepCopyObject().(*fake.Object)
+			return nil
+		}),
+		MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+			if obj.GetResourceVersion() != stored.GetResourceVersion() {
+				return kerrors.NewConflict(schema.GroupResource{}, "", errors.New("stale"))
+			}
+			stored = obj.DeepCopyObject().(*fake.Object)
+			stored.SetResourceVersion(stored.GetResourceVersion() + "+")
+			obj.SetResourceVersion(stored.GetResourceVersion())
+			return nil
+		},
+	}
+
+	obj := &fake.Object{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1"}}
+	if err := NewAPIFinalizer(c, "veryfinal").AddFinalizer(context.Background(), obj); err != nil {
+		t.Fatalf("AddFinalizer(...): unexpected error: %s", err)
+	}
+	if err := c.Update(context.Background(), obj); err != nil {
+		t.Errorf("Update(...): the object should be usable for a later update after a conflicting AddFinalizer: %s", err)
+	}
+}
+
+func TestEnsureConnectionSecretOwner(t *testing.T) {
+	errBoom := errors.New("boom")
+	uid := types.UID("mg-uid")
+
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "cool", UID: uid}}
+	ref := xpv1.SecretReference{Namespace: "ns", Name: "secret"}
+	controller := meta.AsController(meta.TypedReferenceTo(mg, fake.GVK(mg)))
+	other := meta.AsController(&xpv1.TypedReference{Name: "other", UID: types.UID("other-uid")})
+
+	type args struct {
+		c   client.Client
+		mg  Managed
+		ref xpv1.SecretReference
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   error
+	}{
+		"GetSecretError": {
+			reason: "We should return any error encountered getting the connection secret.",
+			args: args{
+				c:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				mg:  mg,
+				ref: ref,
+			},
+			want: errors.Wrap(errBoom, errGetSecret),
+		},
+		"SecretNotFound": {
+			reason: "We should not return an error if the connection secret does not exist.",
+			args: args{
+				c:   &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, ref.Name))},
+				mg:  mg,
+				ref: ref,
+			},
+			want: nil,
+		},
+		"AlreadyControlled": {
+			reason: "We should not update a connection secret that the managed resource already controls.",
+			args: args{
+				c: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						obj.SetOwnerReferences([]metav1.OwnerReference{controller})
+						return nil
+					}),
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				mg:  mg,
+				ref: ref,
+			},
+			want: nil,
+		},
+		"ControlledByOther": {
+			reason: "We should not update a connection secret that is controlled by a different resource.",
+			args: args{
+				c: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						obj.SetOwnerReferences([]metav1.OwnerReference{other})
+						return nil
+					}),
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				mg:  mg,
+				ref: ref,
+			},
+			want: nil,
+		},
+		"UpdateError": {
+			reason: "We should return any error encountered updating the connection secret.",
+			args: args{
+				c: &test.MockClient{
+					MockGet:    test.NewMockGetFn(nil),
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+					MockScheme: test.NewMockSchemeFn(fake.SchemeWith(&fake.Managed{})),
+				},
+				mg:  mg,
+				ref: ref,
+			},
+			want: errors.Wrap(errBoom, errUpdateSecret),
+		},
+		"Success": {
+			reason: "We should add a controller reference to an uncontrolled connection secret.",
+			args: args{
+				c: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+					MockUpdate: test.NewMockUpdateFn(nil, func(obj client.Object) error {
+						if diff := cmp.Diff([]metav1.OwnerReference{controller}, obj.GetOwnerReferences()); diff != "" {
+							t.Errorf("Update(...): -want owner references, +got owner references:\n%s", diff)
+						}
+						return nil
+					}),
+					MockScheme: test.NewMockSchemeFn(fake.SchemeWith(&fake.Managed{})),
+				},
+				mg:  mg,
+				ref: ref,
+			},
+			want: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := EnsureConnectionSecretOwner(context.Background(), tc.args.c, tc.args.mg, tc.args.ref)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nEnsureConnectionSecretOwner(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+// IndexKeyOwnerUID is the key of the field index registered by an OwnerIndex.
+const IndexKeyOwnerUID = "metadata.ownerReferences.uid"
+
+const (
+	errRegisterOwnerIndex = "cannot register owner UID field index"
+	errListOwned          = "cannot list objects by owner UID"
+)
+
+// IndexByOwnerUID returns the UIDs of the owners of the supplied object. It
+// may be used to index objects by the UIDs of their owners.
+func IndexByOwnerUID(o client.Object) []string {
+	refs := o.GetOwnerReferences()
+	uids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		uids = append(uids, string(ref.UID))
+	}
+	return uids
+}
+
+// An OwnerIndex lists objects of a particular kind by the UIDs of their
+// owners, using a field index. Listing by field index is much faster than
+// listing all objects and filtering them by owner reference when there are
+// many objects.
+type OwnerIndex struct {
+	client client.Reader
+	gvk    schema.GroupVersionKind
+}
+
+// NewOwnerIndex returns an OwnerIndex of objects of the supplied kind. The
+// supplied client.Reader must be backed by a cache with which the OwnerIndex
+// has been registered.
+func NewOwnerIndex(c client.Reader, gvk schema.GroupVersionKind) *OwnerIndex {
+	return &OwnerIndex{client: c, gvk: gvk}
+}
+
+// Register the OwnerIndex with the supplied field indexer, for example the
+// one returned by a controller-runtime manager's GetFieldIndexer method. The
+// OwnerIndex must be registered before its cache is started.
+func (i *OwnerIndex) Register(ctx context.Context, fi client.FieldIndexer) error {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(i.gvk)
+	return errors.Wrap(fi.IndexField(ctx, u, IndexKeyOwnerUID, IndexByOwnerUID), errRegisterOwnerIndex)
+}
+
+// ListOwnedBy returns all objects of the OwnerIndex's kind that are owned by
+// the object with the supplied UID.
+func (i *OwnerIndex) ListOwnedBy(ctx context.Context, owner types.UID) ([]unstructured.Unstructured, error) {
+	l := &unstructured.UnstructuredList{}
+	l.SetGroupVersionKind(i.gvk.GroupVersion().WithKind(i.gvk.Kind + "List"))
+	if err := i.client.List(ctx, l, client.MatchingFields{IndexKeyOwnerUID: string(owner)}); err != nil {
+		return nil, errors.Wrap(err, errListOwned)
+	}
+	return l.Items, nil
+}
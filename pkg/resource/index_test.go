@@ -0,0 +1,144 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type fieldIndexerFn func(ctx context.Context, obj client.Object, field string, extract client.IndexerFunc) error
+
+func (fn fieldIndexerFn) IndexField(ctx context.Context, obj client.Object, field string, extract client.IndexerFunc) error {
+	return fn(ctx, obj, field, extract)
+}
+
+func TestIndexByOwnerUID(t *testing.T) {
+	o := &unstructured.Unstructured{}
+	o.SetOwnerReferences([]metav1.OwnerReference{{UID: types.UID("a")}, {UID: types.UID("b")}})
+
+	want := []string{"a", "b"}
+	if diff := cmp.Diff(want, IndexByOwnerUID(o)); diff != "" {
+		t.Errorf("IndexByOwnerUID(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestOwnerIndexRegister(t *testing.T) {
+	errBoom := errors.New("boom")
+	gvk := schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Cool"}
+
+	cases := map[string]struct {
+		reason string
+		fi     client.FieldIndexer
+		want   error
+	}{
+		"IndexFieldError": {
+			reason: "Errors registering the field index should be returned.",
+			fi: fieldIndexerFn(func(_ context.Context, _ client.Object, _ string, _ client.IndexerFunc) error {
+				return errBoom
+			}),
+			want: errors.Wrap(errBoom, errRegisterOwnerIndex),
+		},
+		"Success": {
+			reason: "The owner UID field index should be registered for the index's kind.",
+			fi: fieldIndexerFn(func(_ context.Context, obj client.Object, field string, _ client.IndexerFunc) error {
+				if diff := cmp.Diff(gvk, obj.GetObjectKind().GroupVersionKind()); diff != "" {
+					t.Errorf("IndexField(...): -want GVK, +got GVK:\n%s", diff)
+				}
+				if field != IndexKeyOwnerUID {
+					t.Errorf("IndexField(...): want field %q, got %q", IndexKeyOwnerUID, field)
+				}
+				return nil
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewOwnerIndex(&test.MockClient{}, gvk).Register(context.Background(), tc.fi)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRegister(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestOwnerIndexListOwnedBy(t *testing.T) {
+	errBoom := errors.New("boom")
+	gvk := schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Cool"}
+	owner := types.UID("owner")
+
+	owned := unstructured.Unstructured{}
+	owned.SetName("cool")
+
+	type want struct {
+		items []unstructured.Unstructured
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      client.Reader
+		want   want
+	}{
+		"ListError": {
+			reason: "Errors listing owned objects should be returned.",
+			c:      &test.MockClient{MockList: test.NewMockListFn(errBoom)},
+			want: want{
+				err: errors.Wrap(errBoom, errListOwned),
+			},
+		},
+		"Success": {
+			reason: "Objects of the index's kind should be listed by owner UID.",
+			c: &test.MockClient{MockList: func(_ context.Context, obj client.ObjectList, opts ...client.ListOption) error {
+				if diff := cmp.Diff(gvk.GroupVersion().WithKind("CoolList"), obj.GetObjectKind().GroupVersionKind()); diff != "" {
+					t.Errorf("List(...): -want GVK, +got GVK:\n%s", diff)
+				}
+				if diff := cmp.Diff([]client.ListOption{client.MatchingFields{IndexKeyOwnerUID: string(owner)}}, opts); diff != "" {
+					t.Errorf("List(...): -want options, +got options:\n%s", diff)
+				}
+				obj.(*unstructured.UnstructuredList).Items = []unstructured.Unstructured{owned}
+				return nil
+			}},
+			want: want{
+				items: []unstructured.Unstructured{owned},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewOwnerIndex(tc.c, gvk).ListOwnedBy(context.Background(), owner)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nListOwnedBy(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.items, got); diff != "" {
+				t.Errorf("\n%s\nListOwnedBy(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
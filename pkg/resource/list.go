@@ -0,0 +1,60 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+const (
+	errFmtListKind = "cannot list objects of kind %s"
+)
+
+// EachOfKind lists all objects of the supplied kind, calling the supplied
+// function with each object. Objects are listed in pages of the supplied size,
+// so that only one page of objects is held in memory at a time. All objects are
+// listed in a single request if the page size is zero or less. EachOfKind
+// stops and returns the error if the supplied function returns an error.
+func EachOfKind(ctx context.Context, c client.Reader, gvk schema.GroupVersionKind, pageSize int, fn func(u unstructured.Unstructured) error) error {
+	opts := []client.ListOption{}
+	if pageSize > 0 {
+		opts = append(opts, client.Limit(int64(pageSize)))
+	}
+
+	token := ""
+	for {
+		l := &unstructured.UnstructuredList{}
+		l.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := c.List(ctx, l, append(opts, client.Continue(token))...); err != nil {
+			return errors.Wrapf(err, errFmtListKind, gvk)
+		}
+		for _, u := range l.Items {
+			if err := fn(u); err != nil {
+				return err
+			}
+		}
+		if token = l.GetContinue(); token == "" {
+			return nil
+		}
+	}
+}
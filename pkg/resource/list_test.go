@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestEachOfKind(t *testing.T) {
+	errBoom := errors.New("boom")
+	gvk := schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Cool"}
+
+	// pages lists objects named a and b on the first page, and c on the
+	// second.
+	pages := func(_ context.Context, obj client.ObjectList, opts ...client.ListOption) error {
+		lo := &client.ListOptions{}
+		lo.ApplyOptions(opts)
+		if lo.Limit != 2 {
+			return errors.Errorf("unexpected page size %d", lo.Limit)
+		}
+		l := obj.(*unstructured.UnstructuredList)
+		if l.GetKind() != "CoolList" {
+			return errors.Errorf("unexpected list kind %s", l.GetKind())
+		}
+		names := []string{"c"}
+		if lo.Continue == "" {
+			names = []string{"a", "b"}
+			l.SetContinue("next")
+		}
+		for _, n := range names {
+			u := unstructured.Unstructured{}
+			u.SetName(n)
+			l.Items = append(l.Items, u)
+		}
+		return nil
+	}
+
+	type want struct {
+		names []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      client.Reader
+		fn     func(got *[]string) func(u unstructured.Unstructured) error
+		want   want
+	}{
+		"ListError": {
+			reason: "Errors listing objects should be returned.",
+			c:      &test.MockClient{MockList: test.NewMockListFn(errBoom)},
+			fn: func(got *[]string) func(u unstructured.Unstructured) error {
+				return func(u unstructured.Unstructured) error { return nil }
+			},
+			want: want{err: errors.Wrapf(errBoom, errFmtListKind, gvk)},
+		},
+		"FnError": {
+			reason: "Listing should stop at the first object for which the supplied function returns an error.",
+			c:      &test.MockClient{MockList: pages},
+			fn: func(got *[]string) func(u unstructured.Unstructured) error {
+				return func(u unstructured.Unstructured) error {
+					*got = append(*got, u.GetName())
+					return errBoom
+				}
+			},
+			want: want{names: []string{"a"}, err: errBoom},
+		},
+		"AllPages": {
+			reason: "The supplied function should be called for each object on every page.",
+			c:      &test.MockClient{MockList: pages},
+			fn: func(got *[]string) func(u unstructured.Unstructured) error {
+				return func(u unstructured.Unstructured) error {
+					*got = append(*got, u.GetName())
+					return nil
+				}
+			},
+			want: want{names: []string{"a", "b", "c"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			err := EachOfKind(context.Background(), tc.c, gvk, 2, tc.fn(&got))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nEachOfKind(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.names, got); diff != "" {
+				t.Errorf("\n%s\nEachOfKind(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
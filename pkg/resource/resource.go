@@ -18,6 +18,7 @@ package resource
 
 import (
 	"context"
+	"reflect"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -262,6 +263,44 @@ func NewApplicatorWithRetry(applicator Applicator, shouldRetry shouldRetryFunc,
 	return result
 }
 
+// A RetryingApplicator applies changes to an object, retrying when the apply
+// fails due to a conflict with a concurrent write. It is an
+// ApplicatorWithRetry that retries conflicts, except that each retry re-applies
+// the originally desired object, allowing the wrapped Applicator to re-read the
+// current object before patching or updating it.
+type RetryingApplicator struct {
+	inner   Applicator
+	backoff wait.Backoff
+}
+
+// NewRetryingApplicator returns a RetryingApplicator that wraps the supplied
+// Applicator, retrying conflicts per the supplied backoff.
+func NewRetryingApplicator(inner Applicator, backoff wait.Backoff) *RetryingApplicator {
+	return &RetryingApplicator{inner: inner, backoff: backoff}
+}
+
+// Apply changes to the supplied object, retrying on conflicts until the
+// backoff is exhausted.
+func (a *RetryingApplicator) Apply(ctx context.Context, o client.Object, ao ...ApplyOption) error {
+	desired := o.DeepCopyObject()
+	attempt := 0
+	reset := ApplyFn(func(ctx context.Context, o client.Object, ao ...ApplyOption) error {
+		if attempt > 0 {
+			// A failed attempt may have overwritten the desired object with
+			// the current state of the object; start over from the desired
+			// state.
+			reflect.ValueOf(o).Elem().Set(reflect.ValueOf(desired.DeepCopyObject()).Elem())
+		}
+		attempt++
+		return a.inner.Apply(ctx, o, ao...)
+	})
+	err := NewApplicatorWithRetry(reset, kerrors.IsConflict, &a.backoff).Apply(ctx, o, ao...)
+	if kerrors.IsConflict(err) {
+		return errors.Wrap(err, errRetryConflict)
+	}
+	return err
+}
+
 // A ClientApplicator may be used to build a single 'client' that satisfies both
 // client.Client and Applicator.
 type ClientApplicator struct {
@@ -832,6 +832,82 @@ func TestApplicatorWithRetry_Apply(t *testing.T) {
 	}
 }
 
+func TestRetryingApplicator(t *testing.T) {
+	conflict := kerrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, name, errTest)
+
+	// conflicts returns an Applicator that fails with a conflict the supplied
+	// number of times, overwriting the desired object with 'current' data to
+	// emulate reading the current object. It records the desired data it was
+	// asked to apply each time it is called.
+	conflicts := func(n int, seen *[]string) ApplyFn {
+		calls := 0
+		return func(_ context.Context, o client.Object, _ ...ApplyOption) error {
+			cm := o.(*corev1.ConfigMap)
+			*seen = append(*seen, cm.Data["k"])
+			calls++
+			if calls <= n {
+				cm.Data["k"] = "current"
+				return conflict
+			}
+			return nil
+		}
+	}
+
+	type want struct {
+		err  error
+		seen []string
+	}
+
+	cases := map[string]struct {
+		reason    string
+		conflicts int
+		steps     int
+		want      want
+	}{
+		"Success": {
+			reason:    "No retries should happen if the first apply succeeds.",
+			conflicts: 0,
+			steps:     testSteps,
+			want: want{
+				seen: []string{"desired"},
+			},
+		},
+		"RetryConflict": {
+			reason:    "Conflicts should be retried using the originally desired object.",
+			conflicts: 2,
+			steps:     testSteps,
+			want: want{
+				seen: []string{"desired", "desired", "desired"},
+			},
+		},
+		"BackoffExhausted": {
+			reason:    "The last conflict should be returned, wrapped, once the backoff is exhausted.",
+			conflicts: testSteps,
+			steps:     testSteps,
+			want: want{
+				err:  errors.Wrap(conflict, errRetryConflict),
+				seen: []string{"desired", "desired", "desired"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			seen := make([]string, 0)
+			a := NewRetryingApplicator(conflicts(tc.conflicts, &seen), wait.Backoff{Steps: tc.steps})
+			cm := &corev1.ConfigMap{Data: map[string]string{"k": "desired"}}
+
+			err := a.Apply(context.Background(), cm)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.seen, seen); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want applied, +got applied:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	type args struct {
 		fn      func(current, desired runtime.Object)
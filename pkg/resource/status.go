@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+)
+
+const (
+	errStatusConditions         = "cannot get status conditions"
+	errStatusObservedGeneration = "cannot get status observed generation"
+	errStatusAtProvider         = "cannot get status at provider"
+)
+
+// A Snapshot of the commonly used fields of an object's status.
+type Snapshot struct {
+	// Conditions of the object, from status.conditions.
+	Conditions xpv1.ConditionedStatus
+
+	// ObservedGeneration of the object, from status.observedGeneration.
+	ObservedGeneration int64
+
+	// AtProvider is the observed state of the external resource described by
+	// the object, from status.atProvider.
+	AtProvider map[string]any
+}
+
+// StatusSnapshot returns a Snapshot of the status of the supplied object. Any
+// field that is not set is left at its zero value. An error is returned if a
+// field is set but is not of the expected type.
+func StatusSnapshot(o *unstructured.Unstructured) (Snapshot, error) {
+	p := fieldpath.Pave(o.Object)
+	s := Snapshot{}
+
+	if err := p.GetValueInto("status.conditions", &s.Conditions.Conditions); err != nil && !fieldpath.IsNotFound(err) {
+		return Snapshot{}, errors.Wrap(err, errStatusConditions)
+	}
+
+	g, err := p.GetInteger("status.observedGeneration")
+	if err != nil && !fieldpath.IsNotFound(err) {
+		return Snapshot{}, errors.Wrap(err, errStatusObservedGeneration)
+	}
+	s.ObservedGeneration = g
+
+	ap, err := p.GetValue("status.atProvider")
+	if err != nil && !fieldpath.IsNotFound(err) {
+		return Snapshot{}, errors.Wrap(err, errStatusAtProvider)
+	}
+	if ap != nil {
+		m, ok := ap.(map[string]any)
+		if !ok {
+			return Snapshot{}, errors.Wrap(errors.New("status.atProvider: not an object"), errStatusAtProvider)
+		}
+		s.AtProvider = m
+	}
+
+	return s, nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestStatusSnapshot(t *testing.T) {
+	type want struct {
+		s   Snapshot
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		o      *unstructured.Unstructured
+		want   want
+	}{
+		"NoStatus": {
+			reason: "An object without a status should produce an empty snapshot.",
+			o:      &unstructured.Unstructured{Object: map[string]any{}},
+			want:   want{s: Snapshot{}},
+		},
+		"FullStatus": {
+			reason: "Conditions, observed generation, and observed state should be extracted.",
+			o: &unstructured.Unstructured{Object: map[string]any{
+				"status": map[string]any{
+					"observedGeneration": int64(3),
+					"atProvider":         map[string]any{"id": "cool"},
+					"conditions": []any{
+						map[string]any{
+							"type":               "Ready",
+							"status":             "True",
+							"reason":             "Available",
+							"lastTransitionTime": "2022-01-01T00:00:00Z",
+						},
+					},
+				},
+			}},
+			want: want{s: Snapshot{
+				Conditions:         xpv1.ConditionedStatus{Conditions: []xpv1.Condition{xpv1.Available()}},
+				ObservedGeneration: 3,
+				AtProvider:         map[string]any{"id": "cool"},
+			}},
+		},
+		"InvalidConditions": {
+			reason: "Conditions that are not an array should return an error.",
+			o: &unstructured.Unstructured{Object: map[string]any{
+				"status": map[string]any{"conditions": "wat"},
+			}},
+			want: want{err: errors.Wrap(errors.New("cannot unmarshal value from JSON: json: cannot unmarshal string into Go value of type []v1.Condition"), errStatusConditions)},
+		},
+		"InvalidObservedGeneration": {
+			reason: "An observed generation that is not an integer should return an error.",
+			o: &unstructured.Unstructured{Object: map[string]any{
+				"status": map[string]any{"observedGeneration": "wat"},
+			}},
+			want: want{err: errors.Wrap(errors.New("status.observedGeneration: not a (int64) number"), errStatusObservedGeneration)},
+		},
+		"InvalidAtProvider": {
+			reason: "An observed state that is not an object should return an error.",
+			o: &unstructured.Unstructured{Object: map[string]any{
+				"status": map[string]any{"atProvider": "wat"},
+			}},
+			want: want{err: errors.Wrap(errors.New("status.atProvider: not an object"), errStatusAtProvider)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := StatusSnapshot(tc.o)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nStatusSnapshot(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.s, got, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nStatusSnapshot(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
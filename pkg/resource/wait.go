@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+const (
+	errWaitGet         = "cannot get object"
+	errWaitConditions  = "cannot get status conditions"
+	errFmtWaitTimeout  = "timed out waiting for condition %q to be %q: last observed status %q with reason %q and message %q"
+	errFmtWaitNotFound = "timed out waiting for condition %q to be %q: object does not exist or does not have the condition"
+
+	waitPollInterval = 1 * time.Second
+)
+
+// WaitForCondition polls the supplied object until it has a status condition
+// of the supplied type and status. The object may be typed or unstructured,
+// and must have been created before WaitForCondition is called. The object is
+// polled until the supplied timeout expires or the supplied context is
+// cancelled, at which point an error describing the last condition of the
+// supplied type observed is returned. It is intended for use in tests.
+func WaitForCondition(ctx context.Context, c client.Reader, obj client.Object, ct xpv1.ConditionType, status corev1.ConditionStatus, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	t := time.NewTicker(waitPollInterval)
+	defer t.Stop()
+
+	var last *xpv1.Condition
+	for {
+		cond, err := getCondition(ctx, c, obj, ct)
+		if err != nil {
+			return err
+		}
+		if cond != nil && cond.Status == status {
+			return nil
+		}
+		if cond != nil {
+			last = cond
+		}
+
+		select {
+		case <-ctx.Done():
+			if last == nil {
+				return errors.Errorf(errFmtWaitNotFound, ct, status)
+			}
+			return errors.Errorf(errFmtWaitTimeout, ct, status, last.Status, last.Reason, last.Message)
+		case <-t.C:
+		}
+	}
+}
+
+// getCondition returns the condition of the supplied type, or nil if the
+// object does not exist or does not have the condition.
+func getCondition(ctx context.Context, c client.Reader, obj client.Object, ct xpv1.ConditionType) (*xpv1.Condition, error) {
+	err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+	if kerrors.IsNotFound(err) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errWaitGet)
+	}
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, errors.Wrap(err, errWaitConditions)
+	}
+	snap, err := StatusSnapshot(&unstructured.Unstructured{Object: u})
+	if err != nil {
+		return nil, errors.Wrap(err, errWaitConditions)
+	}
+	// We don't use GetCondition, which returns a condition with unknown
+	// status if the object doesn't have one of the supplied type.
+	for i := range snap.Conditions.Conditions {
+		if snap.Conditions.Conditions[i].Type == ct {
+			return &snap.Conditions.Conditions[i], nil
+		}
+	}
+	return nil, nil
+}
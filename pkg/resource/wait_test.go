@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestWaitForCondition(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	withConditions := func(c ...xpv1.Condition) test.ObjectFn {
+		return func(o client.Object) error {
+			u := o.(*unstructured.Unstructured)
+			conditions := make([]any, len(c))
+			for i := range c {
+				conditions[i] = map[string]any{
+					"type":               string(c[i].Type),
+					"status":             string(c[i].Status),
+					"reason":             string(c[i].Reason),
+					"message":            c[i].Message,
+					"lastTransitionTime": c[i].LastTransitionTime.UTC().Format(time.RFC3339),
+				}
+			}
+			return unstructured.SetNestedSlice(u.Object, conditions, "status", "conditions")
+		}
+	}
+
+	type args struct {
+		c      client.Reader
+		ct     xpv1.ConditionType
+		status corev1.ConditionStatus
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   error
+	}{
+		"ConditionMet": {
+			reason: "We should return without error if the object has the desired condition.",
+			args: args{
+				c:      &test.MockClient{MockGet: test.NewMockGetFn(nil, withConditions(xpv1.Available()))},
+				ct:     xpv1.TypeReady,
+				status: corev1.ConditionTrue,
+			},
+		},
+		"GetError": {
+			reason: "We should return any error encountered getting the object.",
+			args: args{
+				c:      &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				ct:     xpv1.TypeReady,
+				status: corev1.ConditionTrue,
+			},
+			want: errors.Wrap(errBoom, errWaitGet),
+		},
+		"NotFound": {
+			reason: "We should return an error if the object does not exist before the timeout.",
+			args: args{
+				c:      &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "cool"))},
+				ct:     xpv1.TypeReady,
+				status: corev1.ConditionTrue,
+			},
+			want: errors.Errorf(errFmtWaitNotFound, xpv1.TypeReady, corev1.ConditionTrue),
+		},
+		"NoConditions": {
+			reason: "We should not consider an object that does not have the condition to have the condition with unknown status.",
+			args: args{
+				c:      &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+				ct:     xpv1.TypeReady,
+				status: corev1.ConditionUnknown,
+			},
+			want: errors.Errorf(errFmtWaitNotFound, xpv1.TypeReady, corev1.ConditionUnknown),
+		},
+		"ConditionUnknown": {
+			reason: "We should return without error if the object has the desired condition with unknown status.",
+			args: args{
+				c:      &test.MockClient{MockGet: test.NewMockGetFn(nil, withConditions(xpv1.Condition{Type: xpv1.TypeReady, Status: corev1.ConditionUnknown}))},
+				ct:     xpv1.TypeReady,
+				status: corev1.ConditionUnknown,
+			},
+		},
+		"Timeout": {
+			reason: "We should return an error describing the last observed condition if the timeout expires.",
+			args: args{
+				c:      &test.MockClient{MockGet: test.NewMockGetFn(nil, withConditions(xpv1.Creating().WithMessage("still going")))},
+				ct:     xpv1.TypeReady,
+				status: corev1.ConditionTrue,
+			},
+			want: errors.Errorf(errFmtWaitTimeout, xpv1.TypeReady, corev1.ConditionTrue, corev1.ConditionFalse, xpv1.ReasonCreating, "still going"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			u := &unstructured.Unstructured{}
+			u.SetName("cool")
+			err := WaitForCondition(context.Background(), tc.args.c, u, tc.args.ct, tc.args.status, 10*time.Millisecond)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nWaitForCondition(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -50,6 +50,20 @@ func EquateErrors() cmp.Option {
 }
 
 // EquateConditions sorts any slices of Condition before comparing them.
+// Conditions are compared using their Equal method, which ignores their
+// LastTransitionTime. The order of conditions is thus insignificant, as are
+// their transition times.
 func EquateConditions() cmp.Option {
 	return cmpopts.SortSlices(func(i, j xpv1.Condition) bool { return i.Type < j.Type })
 }
+
+// EquateConditionedStatus returns a cmp.Option that treats ConditionedStatuses
+// as equal if they contain equal conditions, ignoring their order and
+// LastTransitionTime. It applies to ConditionedStatus values, for example those
+// embedded in a resource's status. Pointers to ConditionedStatus are compared
+// using their Equal method regardless of this option.
+func EquateConditionedStatus() cmp.Option {
+	return cmp.Comparer(func(a, b xpv1.ConditionedStatus) bool {
+		return a.Equal(&b)
+	})
+}